@@ -15,6 +15,7 @@ type AlwaysMatch struct {
 	ChromeOptions       `json:"goog:chromeOptions,omitempty"`
 	MozOptions          `json:"moz:firefoxOptions,omitempty"`
 	PageLoad            string `json:"pageLoadStrategy,omitempty"`
+	UnhandledPrompt     string `json:"unhandledPromptBehavior,omitempty"`
 }
 
 type Timeouts struct {
@@ -75,6 +76,26 @@ func PageLoadStrategy(st string) CapabilitiesFunc {
 	}
 }
 
+// unhandledPromptBehavior values
+// src: https://www.w3.org/TR/webdriver2/#dfn-user-prompt-handler
+const (
+	PromptDismiss          = "dismiss"
+	PromptAccept           = "accept"
+	PromptDismissAndNotify = "dismiss and notify"
+	PromptAcceptAndNotify  = "accept and notify"
+	PromptIgnore           = "ignore"
+)
+
+// UnhandledPromptBehavior
+// defines how driver handles alert, confirm or prompt
+// opened when next command is sent
+// "notify" and "ignore" behaviors return unexpected alert open error
+func UnhandledPromptBehavior(b string) CapabilitiesFunc {
+	return func(cap *Capabilities) {
+		cap.Capabilities.AlwaysMatch.UnhandledPrompt = b
+	}
+}
+
 func HeadLess() CapabilitiesFunc {
 	return func(cap *Capabilities) {
 		cap.Capabilities.AlwaysMatch.MozOptions = MozOptions{
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -24,22 +25,32 @@ import (
 )
 
 var (
-	ErrorElementId        = "error on map element id.\nValue: %v.\nError: %v"
-	ErrorFindElement      = "error on find element id.\n Value: %v.\nError: %v"
-	ErrorClick            = "error on click element.\nError: %v"
-	ErrorSendKeys         = "error on send keys.\nError: %v"
-	ErrorAttribute        = "error on attribute element.\nError: %v"
-	ErrorScriptExecute    = "error on script execute.\nError: %v"
-	ErrorScreenshot       = "error on screenshot.\nError: %v"
-	ErrorActiveElement    = "error on active element.\nValue: %v.\nError: %v"
-	ErrorTextElement      = "error on text element.\nError: %v"
-	ErrorAction           = "error on action.\nError: %v"
-	ErrorDisplayedElement = "error on displayed element.\nError: %v"
-	ErrorDeleteSession    = "error on delete session.\nError: %v"
-	ErrorCreateSession    = "error on create session.\nError: %v"
-	ErrorStatus           = "error on webdriver status.\nError: %v"
-	ErrorTab              = "error on tabs.\nError: %v"
-	ErrorOpenUrl          = "error on open url.\nError: %v"
+	ErrorElementId        = "error on map element id.\nValue: %v.\nError: %w"
+	ErrorFindElement      = "error on find element id.\n Value: %v.\nError: %w"
+	ErrorClick            = "error on click element.\nError: %w"
+	ErrorSendKeys         = "error on send keys.\nError: %w"
+	ErrorClear            = "error on clear element.\nError: %w"
+	ErrorAttribute        = "error on attribute element.\nError: %w"
+	ErrorScriptExecute    = "error on script execute.\nError: %w"
+	ErrorScreenshot       = "error on screenshot.\nError: %w"
+	ErrorActiveElement    = "error on active element.\nValue: %v.\nError: %w"
+	ErrorTextElement      = "error on text element.\nError: %w"
+	ErrorAction           = "error on action.\nError: %w"
+	ErrorDisplayedElement = "error on displayed element.\nError: %w"
+	ErrorDeleteSession    = "error on delete session.\nError: %w"
+	ErrorCreateSession    = "error on create session.\nError: %w"
+	ErrorStatus           = "error on webdriver status.\nError: %w"
+	ErrorTab              = "error on tabs.\nError: %w"
+	ErrorWindow           = "error on window.\nError: %w"
+	ErrorFrame            = "error on frame.\nError: %w"
+	ErrorShadowRoot       = "error on shadow root.\nError: %w"
+	ErrorOpenUrl          = "error on open url.\nError: %w"
+	ErrorNavigation       = "error on navigation.\nError: %w"
+	ErrorTitle            = "error on title.\nError: %w"
+	ErrorCookie           = "error on cookie.\nError: %w"
+	ErrorAlert            = "error on alert.\nError: %w"
+	ErrorElementState     = "error on element state.\nError: %w"
+	ErrorUploadFile       = "error on upload file.\nError: %w"
 )
 
 const (
@@ -48,7 +59,16 @@ const (
 	sessionEndpoint    = "%s/session"
 	quitEndpoint       = "%s/session/%s"
 	urlEndpoint        = "%s/session/%s/url"
+	backEndpoint       = "%s/session/%s/back"
+	forwardEndpoint    = "%s/session/%s/forward"
+	refreshEndpoint    = "%s/session/%s/refresh"
+	titleEndpoint      = "%s/session/%s/title"
 	screenshotEndpoint = "%s/session/%s/screenshot"
+	uploadFileEndpoint = "%s/session/%s/se/file"
+
+	// W3C Cookies
+	cookiesEndpoint = "%s/session/%s/cookie"
+	cookieEndpoint  = "%s/session/%s/cookie/%s"
 
 	// W3C Element
 	findElementEndpoint  = "%s/session/%s/element"
@@ -58,18 +78,50 @@ const (
 	isDisplayedEndpoint  = "%s/session/%s/element/%s/displayed"
 	clickEndpoint        = "%s/session/%s/element/%s/click"
 	sendKeysEndpoint     = "%s/session/%s/element/%s/value"
+	clearEndpoint        = "%s/session/%s/element/%s/clear"
 	attributeEndpoint    = "%s/session/%s/element/%s/attribute/%s"
 	fromElementEndpoint  = "%s/session/%s/element/%s/element"
 	fromElementsEndpoint = "%s/session/%s/element/%s/elements"
 
+	// W3C Element state
+	propertyEndpoint      = "%s/session/%s/element/%s/property/%s"
+	cssValueEndpoint      = "%s/session/%s/element/%s/css/%s"
+	tagNameEndpoint       = "%s/session/%s/element/%s/name"
+	elementRectEndpoint   = "%s/session/%s/element/%s/rect"
+	enabledEndpoint       = "%s/session/%s/element/%s/enabled"
+	selectedEndpoint      = "%s/session/%s/element/%s/selected"
+	computedRoleEndpoint  = "%s/session/%s/element/%s/computedrole"
+	computedLabelEndpoint = "%s/session/%s/element/%s/computedlabel"
+
+	// W3C Shadow
+	shadowRootEndpoint         = "%s/session/%s/element/%s/shadow"
+	fromShadowElementEndpoint  = "%s/session/%s/shadow/%s/element"
+	fromShadowElementsEndpoint = "%s/session/%s/shadow/%s/elements"
+
 	// W3C Window
 	windowEndpoint        = "%s/session/%s/window"
 	newWindowEndpoint     = "%s/session/%s/window/new"
 	windowHandlesEndpoint = "%s/session/%s/window/handles"
+	windowRectEndpoint    = "%s/session/%s/window/rect"
+	maximizeEndpoint      = "%s/session/%s/window/maximize"
+	minimizeEndpoint      = "%s/session/%s/window/minimize"
+	fullscreenEndpoint    = "%s/session/%s/window/fullscreen"
+
+	// W3C Frame
+	frameEndpoint       = "%s/session/%s/frame"
+	parentFrameEndpoint = "%s/session/%s/frame/parent"
+
+	// W3C User prompts
+	alertTextEndpoint    = "%s/session/%s/alert/text"
+	acceptAlertEndpoint  = "%s/session/%s/alert/accept"
+	dismissAlertEndpoint = "%s/session/%s/alert/dismiss"
 
 	// W3C Action
 	actionEndpoint = "%s/session/%s/actions"
 
+	// W3C Script
+	asyncScriptEndpoint = "%s/session/%s/execute/async"
+
 	// GoST
 	isEndpoint         = "%s/session/%s/element/%s/is"
 	syncScriptEndpoint = "%s/session/%s/script"
@@ -95,7 +147,8 @@ type WebClient struct {
 	// if HeadRedirects is true, the client will follow the redirect also for HEAD requests
 	HeadRedirects bool
 
-	// if Verbose, log request and response info
+	// if Verbose, trace request and response info
+	// set by Trace, TraceFile
 	Verbose bool
 
 	WebConfig          *config.WebConfig
@@ -103,25 +156,43 @@ type WebClient struct {
 	HTTPClient         *http.Client
 	RequestReaderLimit int64
 	// syncMutex  sync.Mutex // Mutex for ensuring thread safety
+
+	tracer *tracer
 }
 
 // newClientV2
 // new client init without Session param
+// starts tracing if TraceFile is configured
 func NewClient() *WebClient {
-	return &WebClient{
+	t := &tracer{
+		next: http.DefaultTransport,
+	}
+
+	c := &WebClient{
 		WebConfig:          config.Config,
 		RequestReaderLimit: 4096,
+		tracer:             t,
 
 		HTTPClient: &http.Client{
 			Transport: &retry{
-				maxRetries: 3,
-				delay:      time.Duration(config.Config.WaitForInterval * time.Millisecond),
+				maxRetries: config.Config.RetryMax,
+				delay:      config.Config.RetryDelay * time.Millisecond,
+				maxDelay:   config.Config.RetryMaxDelay * time.Millisecond,
+				jitter:     config.Config.RetryJitter,
 				next: &loggin{
-					next: http.DefaultTransport,
+					next: t,
 				},
 			},
 		},
 	}
+
+	if config.Config.TraceFile != "" {
+		if err := c.TraceFile(config.Config.TraceFile); err != nil {
+			log.Println(err)
+		}
+	}
+
+	return c
 }
 
 func marshalData(body interface{}) []byte {
@@ -142,10 +213,14 @@ type HttpResponse struct {
 	http.Response
 }
 
+// ErrMissingElementId
+// returned when 2xx response value has no element identifier
+var ErrMissingElementId = errors.New("missing element identifier")
+
 func ElementID(v map[string]string) (string, error) {
 	id, ok := v[config.WebElementIdentifier]
 	if id == "" || !ok {
-		return "", fmt.Errorf(ErrorElementId, v)
+		return "", fmt.Errorf(ErrorElementId, v, ErrMissingElementId)
 	}
 	return id, nil
 }
@@ -156,7 +231,7 @@ func ElementsID(v []map[string]string) ([]string, error) {
 	for _, el := range v {
 		id, ok := el[config.WebElementIdentifier]
 		if !ok || id == "" {
-			return nil, fmt.Errorf(ErrorElementId, v)
+			return nil, fmt.Errorf(ErrorElementId, v, ErrMissingElementId)
 		}
 		els = append(els, id)
 	}
@@ -193,7 +268,14 @@ func (self *WebClient) addHeaders(req *http.Request, headers map[string]string)
 }
 
 func (self *WebClient) Request(method string, urlpath string, body io.Reader) (req *http.Request) {
-	req, err := http.NewRequest(method, urlpath, body)
+	return self.RequestContext(context.Background(), method, urlpath, body)
+}
+
+// RequestContext
+// new request with ctx
+// cancelling ctx aborts in-flight request and client retries
+func (self *WebClient) RequestContext(ctx context.Context, method string, urlpath string, body io.Reader) (req *http.Request) {
+	req, err := http.NewRequestWithContext(ctx, method, urlpath, body)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -203,9 +285,51 @@ func (self *WebClient) Request(method string, urlpath string, body io.Reader) (r
 
 	self.addHeaders(req, map[string]string{"Content-Type": "application/json"})
 
+	if timeout, ok := WaitTimeout(ctx); ok {
+		req.Header.Set(config.WaitTimeoutHeader, timeout.String())
+	}
+
+	if interval, ok := WaitInterval(ctx); ok {
+		req.Header.Set(config.WaitIntervalHeader, interval.String())
+	}
+
 	return
 }
 
+type waitTimeoutKey struct{}
+
+type waitIntervalKey struct{}
+
+// WithWaitTimeout
+// returns ctx with service wait timeout
+// requests made with ctx override WaitForTimeout
+// of service retry loops, i.e. find element, is displayed
+func WithWaitTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, waitTimeoutKey{}, timeout)
+}
+
+// WithWaitInterval
+// returns ctx with service wait interval
+// requests made with ctx override WaitForInterval
+// between service retries
+func WithWaitInterval(ctx context.Context, interval time.Duration) context.Context {
+	return context.WithValue(ctx, waitIntervalKey{}, interval)
+}
+
+// WaitTimeout
+// returns wait timeout set by WithWaitTimeout
+func WaitTimeout(ctx context.Context) (time.Duration, bool) {
+	timeout, ok := ctx.Value(waitTimeoutKey{}).(time.Duration)
+	return timeout, ok
+}
+
+// WaitInterval
+// returns wait interval set by WithWaitInterval
+func WaitInterval(ctx context.Context) (time.Duration, bool) {
+	interval, ok := ctx.Value(waitIntervalKey{}).(time.Duration)
+	return interval, ok
+}
+
 func CloseResponse(r *http.Response) {
 	if r != nil && r.Body != nil {
 		io.Copy(io.Discard, r.Body)
@@ -218,37 +342,84 @@ func (self *WebClient) Do(req *http.Request) (*HttpResponse, error) {
 	if urlerr, ok := err.(*url.Error); ok && urlerr.Err == errors.New("No redirect") {
 		err = nil // redirect on HEAD is not an error
 	}
-	if err == nil {
-		return &HttpResponse{*resp}, nil
-	} else {
+	if err != nil {
 		CloseResponse(resp)
 		return nil, err
 	}
+
+	// non-2xx responses are decoded into W3C WebDriverError
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		defer CloseResponse(resp)
+		return nil, decodeError(resp)
+	}
+
+	return &HttpResponse{*resp}, nil
 }
 
 func (self *WebClient) Post(path string, content io.Reader) (*HttpResponse, error) {
-	req := self.Request(http.MethodPost, path, content)
+	return self.PostContext(context.Background(), path, content)
+}
+
+func (self *WebClient) PostContext(ctx context.Context, path string, content io.Reader) (*HttpResponse, error) {
+	req := self.RequestContext(ctx, http.MethodPost, path, content)
 	return self.Do(req)
 }
 
 func (self *WebClient) Get(path string) (*HttpResponse, error) {
-	req := self.Request(http.MethodGet, path, nil)
+	return self.GetContext(context.Background(), path)
+}
+
+func (self *WebClient) GetContext(ctx context.Context, path string) (*HttpResponse, error) {
+	req := self.RequestContext(ctx, http.MethodGet, path, nil)
 	return self.Do(req)
 }
 
 func (self *WebClient) Delete(path string) (*HttpResponse, error) {
-	req := self.Request(http.MethodDelete, path, nil)
+	return self.DeleteContext(context.Background(), path)
+}
+
+func (self *WebClient) DeleteContext(ctx context.Context, path string) (*HttpResponse, error) {
+	req := self.RequestContext(ctx, http.MethodDelete, path, nil)
 	return self.Do(req)
 }
 
 func (c *WebClient) Url(url, sessionId string) (*data.Url, error) {
+	return c.UrlContext(context.Background(), url, sessionId)
+}
+
+func (c *WebClient) UrlContext(ctx context.Context, url, sessionId string) (*data.Url, error) {
 	b := marshalData(map[string]string{"url": url})
 	u := fmt.Sprintf(urlEndpoint, c.WebConfig.WebServerAddr, sessionId)
-	res, err := c.Post(u, bytes.NewBuffer(b))
+	res, err := c.PostContext(ctx, u, bytes.NewBuffer(b))
+	if err != nil {
+		return nil, fmt.Errorf(ErrorOpenUrl, err)
+	}
+
+	defer res.Body.Close()
+
+	reply := new(struct{ Value string })
+	unmarshalRes(&res.Response, reply)
+
+	return &data.Url{
+		Url: reply.Value,
+	}, nil
+}
+
+func (c *WebClient) Open(url, sessionId string) (*data.Url, error) {
+	return c.OpenContext(context.Background(), url, sessionId)
+}
+
+func (c *WebClient) OpenContext(ctx context.Context, url, sessionId string) (*data.Url, error) {
+	b := marshalData(map[string]string{"url": url})
+	p := fmt.Sprintf(urlEndpoint, c.WebConfig.WebServerAddr, sessionId)
+
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(b))
 	if err != nil {
 		return nil, fmt.Errorf(ErrorOpenUrl, err)
 	}
 
+	defer res.Body.Close()
+
 	reply := new(struct{ Value string })
 	unmarshalRes(&res.Response, reply)
 
@@ -257,13 +428,425 @@ func (c *WebClient) Url(url, sessionId string) (*data.Url, error) {
 	}, nil
 }
 
-func (c *WebClient) Open(url, sessionId string) (*data.Url, error) {
-	b := marshalData(map[string]string{"url": url})
-	p := fmt.Sprintf(urlEndpoint, c.WebConfig.WebServerAddr, sessionId)
+func (c *WebClient) CurrentURL(sessionId string) (string, error) {
+	return c.CurrentURLContext(context.Background(), sessionId)
+}
+
+// CurrentURLContext
+// returns url of current top-level browsing context
+func (c *WebClient) CurrentURLContext(ctx context.Context, sessionId string) (string, error) {
+	p := fmt.Sprintf(urlEndpoint, c.WebConfig.WebServerAddr, sessionId)
+	res, err := c.GetContext(ctx, p)
+	if err != nil {
+		return "", fmt.Errorf(ErrorNavigation, err)
+	}
+
+	defer res.Body.Close()
+
+	reply := new(struct{ Value string })
+	unmarshalRes(&res.Response, reply)
+
+	return reply.Value, nil
+}
+
+func (c *WebClient) Title(sessionId string) (string, error) {
+	return c.TitleContext(context.Background(), sessionId)
+}
+
+func (c *WebClient) TitleContext(ctx context.Context, sessionId string) (string, error) {
+	p := fmt.Sprintf(titleEndpoint, c.WebConfig.WebServerAddr, sessionId)
+	res, err := c.GetContext(ctx, p)
+	if err != nil {
+		return "", fmt.Errorf(ErrorTitle, err)
+	}
+
+	defer res.Body.Close()
+
+	reply := new(struct{ Value string })
+	unmarshalRes(&res.Response, reply)
+
+	return reply.Value, nil
+}
+
+func (c *WebClient) Back(sessionId string) error {
+	return c.BackContext(context.Background(), sessionId)
+}
+
+func (c *WebClient) BackContext(ctx context.Context, sessionId string) error {
+	return c.navigate(ctx, backEndpoint, sessionId)
+}
+
+func (c *WebClient) Forward(sessionId string) error {
+	return c.ForwardContext(context.Background(), sessionId)
+}
+
+func (c *WebClient) ForwardContext(ctx context.Context, sessionId string) error {
+	return c.navigate(ctx, forwardEndpoint, sessionId)
+}
+
+func (c *WebClient) Refresh(sessionId string) error {
+	return c.RefreshContext(context.Background(), sessionId)
+}
+
+func (c *WebClient) RefreshContext(ctx context.Context, sessionId string) error {
+	return c.navigate(ctx, refreshEndpoint, sessionId)
+}
+
+// navigate
+// posts empty body to history endpoint
+// i.e. back, forward, refresh
+func (c *WebClient) navigate(ctx context.Context, endpoint, sessionId string) error {
+	b := marshalData(&data.Empty{})
+	p := fmt.Sprintf(endpoint, c.WebConfig.WebServerAddr, sessionId)
+
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(b))
+	if err != nil {
+		return fmt.Errorf(ErrorNavigation, err)
+	}
+
+	defer res.Body.Close()
+
+	return nil
+}
+
+func (c *WebClient) GetCookies(sessionId string) ([]*data.Cookie, error) {
+	return c.GetCookiesContext(context.Background(), sessionId)
+}
+
+// GetCookiesContext
+// returns cookies visible to current page
+func (c *WebClient) GetCookiesContext(ctx context.Context, sessionId string) ([]*data.Cookie, error) {
+	p := fmt.Sprintf(cookiesEndpoint, c.WebConfig.WebServerAddr, sessionId)
+	res, err := c.GetContext(ctx, p)
+	if err != nil {
+		return nil, fmt.Errorf(ErrorCookie, err)
+	}
+
+	defer res.Body.Close()
+
+	reply := new(struct{ Value []*data.Cookie })
+	if err := unmarshalRes(&res.Response, reply); err != nil {
+		return nil, fmt.Errorf(ErrorCookie, err)
+	}
+
+	return reply.Value, nil
+}
+
+func (c *WebClient) GetCookie(name, sessionId string) (*data.Cookie, error) {
+	return c.GetCookieContext(context.Background(), name, sessionId)
+}
+
+// GetCookieContext
+// returns named cookie
+// ErrNoSuchCookie if not set
+func (c *WebClient) GetCookieContext(ctx context.Context, name, sessionId string) (*data.Cookie, error) {
+	p := fmt.Sprintf(cookieEndpoint, c.WebConfig.WebServerAddr, sessionId, url.PathEscape(name))
+	res, err := c.GetContext(ctx, p)
+	if err != nil {
+		return nil, fmt.Errorf(ErrorCookie, err)
+	}
+
+	defer res.Body.Close()
+
+	reply := new(struct{ Value *data.Cookie })
+	if err := unmarshalRes(&res.Response, reply); err != nil {
+		return nil, fmt.Errorf(ErrorCookie, err)
+	}
+
+	return reply.Value, nil
+}
+
+func (c *WebClient) AddCookie(cookie *data.Cookie, sessionId string) error {
+	return c.AddCookieContext(context.Background(), cookie, sessionId)
+}
+
+// AddCookieContext
+// adds cookie to current page domain
+func (c *WebClient) AddCookieContext(ctx context.Context, cookie *data.Cookie, sessionId string) error {
+	b := marshalData(map[string]*data.Cookie{"cookie": cookie})
+	p := fmt.Sprintf(cookiesEndpoint, c.WebConfig.WebServerAddr, sessionId)
+
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(b))
+	if err != nil {
+		return fmt.Errorf(ErrorCookie, err)
+	}
+
+	defer res.Body.Close()
+
+	return nil
+}
+
+func (c *WebClient) DeleteCookie(name, sessionId string) error {
+	return c.DeleteCookieContext(context.Background(), name, sessionId)
+}
+
+func (c *WebClient) DeleteCookieContext(ctx context.Context, name, sessionId string) error {
+	p := fmt.Sprintf(cookieEndpoint, c.WebConfig.WebServerAddr, sessionId, url.PathEscape(name))
+	res, err := c.DeleteContext(ctx, p)
+	if err != nil {
+		return fmt.Errorf(ErrorCookie, err)
+	}
+
+	defer res.Body.Close()
+
+	return nil
+}
+
+func (c *WebClient) DeleteAllCookies(sessionId string) error {
+	return c.DeleteAllCookiesContext(context.Background(), sessionId)
+}
+
+func (c *WebClient) DeleteAllCookiesContext(ctx context.Context, sessionId string) error {
+	p := fmt.Sprintf(cookiesEndpoint, c.WebConfig.WebServerAddr, sessionId)
+	res, err := c.DeleteContext(ctx, p)
+	if err != nil {
+		return fmt.Errorf(ErrorCookie, err)
+	}
+
+	defer res.Body.Close()
+
+	return nil
+}
+
+func (c *WebClient) NewTab(sessionId string) (string, error) {
+	return c.NewTabContext(context.Background(), sessionId)
+}
+
+// NewTabContext
+// opens new tab
+// returns new tab handle
+func (c *WebClient) NewTabContext(ctx context.Context, sessionId string) (string, error) {
+	w, err := c.NewWindowContext(ctx, "tab", sessionId)
+	if err != nil {
+		return "", fmt.Errorf(ErrorTab, err)
+	}
+
+	return w.Handle, nil
+}
+
+func (c *WebClient) NewWindow(typ, sessionId string) (*data.NewWindow, error) {
+	return c.NewWindowContext(context.Background(), typ, sessionId)
+}
+
+// NewWindowContext
+// opens new top-level browsing context
+// typ is type hint, "tab" or "window"
+// driver may open other type
+func (c *WebClient) NewWindowContext(ctx context.Context, typ, sessionId string) (*data.NewWindow, error) {
+	b := marshalData(map[string]string{"type": typ})
+	p := fmt.Sprintf(newWindowEndpoint, c.WebConfig.WebServerAddr, sessionId)
+
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(b))
+	if err != nil {
+		return nil, fmt.Errorf(ErrorWindow, err)
+	}
+
+	defer res.Body.Close()
+
+	reply := new(struct{ Value *data.NewWindow })
+	if err := unmarshalRes(&res.Response, reply); err != nil {
+		return nil, fmt.Errorf(ErrorWindow, err)
+	}
+
+	return reply.Value, nil
+}
+
+func (c *WebClient) WindowHandle(sessionId string) (string, error) {
+	return c.WindowHandleContext(context.Background(), sessionId)
+}
+
+// WindowHandleContext
+// returns current window handle
+func (c *WebClient) WindowHandleContext(ctx context.Context, sessionId string) (string, error) {
+	p := fmt.Sprintf(windowEndpoint, c.WebConfig.WebServerAddr, sessionId)
+	res, err := c.GetContext(ctx, p)
+	if err != nil {
+		return "", fmt.Errorf(ErrorWindow, err)
+	}
+
+	defer res.Body.Close()
+
+	reply := new(struct{ Value string })
+	unmarshalRes(&res.Response, reply)
+
+	return reply.Value, nil
+}
+
+func (c *WebClient) SwitchWindow(handle, sessionId string) error {
+	return c.SwitchWindowContext(context.Background(), handle, sessionId)
+}
+
+func (c *WebClient) SwitchWindowContext(ctx context.Context, handle, sessionId string) error {
+	b := marshalData(map[string]string{"handle": handle})
+	p := fmt.Sprintf(windowEndpoint, c.WebConfig.WebServerAddr, sessionId)
+
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(b))
+	if err != nil {
+		return fmt.Errorf(ErrorWindow, err)
+	}
+
+	defer res.Body.Close()
+
+	return nil
+}
+
+func (c *WebClient) CloseWindow(sessionId string) ([]string, error) {
+	return c.CloseWindowContext(context.Background(), sessionId)
+}
+
+// CloseWindowContext
+// closes current window
+// returns remaining window handles
+// switch to one of them to continue
+func (c *WebClient) CloseWindowContext(ctx context.Context, sessionId string) ([]string, error) {
+	p := fmt.Sprintf(windowEndpoint, c.WebConfig.WebServerAddr, sessionId)
+	res, err := c.DeleteContext(ctx, p)
+	if err != nil {
+		return nil, fmt.Errorf(ErrorWindow, err)
+	}
+
+	defer res.Body.Close()
+
+	reply := new(struct{ Value []string })
+	unmarshalRes(&res.Response, reply)
+
+	return reply.Value, nil
+}
+
+func (c *WebClient) WindowRect(sessionId string) (*data.Rect, error) {
+	return c.WindowRectContext(context.Background(), sessionId)
+}
+
+func (c *WebClient) WindowRectContext(ctx context.Context, sessionId string) (*data.Rect, error) {
+	p := fmt.Sprintf(windowRectEndpoint, c.WebConfig.WebServerAddr, sessionId)
+	res, err := c.GetContext(ctx, p)
+	if err != nil {
+		return nil, fmt.Errorf(ErrorWindow, err)
+	}
+
+	return windowRect(res)
+}
+
+func (c *WebClient) SetWindowRect(rect *data.WindowRect, sessionId string) (*data.Rect, error) {
+	return c.SetWindowRectContext(context.Background(), rect, sessionId)
+}
+
+// SetWindowRectContext
+// moves and resizes current window
+// only set rect fields are sent
+// returns resulting window rect
+func (c *WebClient) SetWindowRectContext(ctx context.Context, rect *data.WindowRect, sessionId string) (*data.Rect, error) {
+	b := marshalData(rect)
+	p := fmt.Sprintf(windowRectEndpoint, c.WebConfig.WebServerAddr, sessionId)
+
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(b))
+	if err != nil {
+		return nil, fmt.Errorf(ErrorWindow, err)
+	}
+
+	return windowRect(res)
+}
+
+func (c *WebClient) Maximize(sessionId string) (*data.Rect, error) {
+	return c.MaximizeContext(context.Background(), sessionId)
+}
+
+func (c *WebClient) MaximizeContext(ctx context.Context, sessionId string) (*data.Rect, error) {
+	return c.windowState(ctx, maximizeEndpoint, sessionId)
+}
+
+func (c *WebClient) Minimize(sessionId string) (*data.Rect, error) {
+	return c.MinimizeContext(context.Background(), sessionId)
+}
+
+func (c *WebClient) MinimizeContext(ctx context.Context, sessionId string) (*data.Rect, error) {
+	return c.windowState(ctx, minimizeEndpoint, sessionId)
+}
+
+func (c *WebClient) Fullscreen(sessionId string) (*data.Rect, error) {
+	return c.FullscreenContext(context.Background(), sessionId)
+}
+
+func (c *WebClient) FullscreenContext(ctx context.Context, sessionId string) (*data.Rect, error) {
+	return c.windowState(ctx, fullscreenEndpoint, sessionId)
+}
+
+// windowState
+// posts empty body to window state endpoint
+// i.e. maximize, minimize, fullscreen
+func (c *WebClient) windowState(ctx context.Context, endpoint, sessionId string) (*data.Rect, error) {
+	b := marshalData(&data.Empty{})
+	p := fmt.Sprintf(endpoint, c.WebConfig.WebServerAddr, sessionId)
+
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(b))
+	if err != nil {
+		return nil, fmt.Errorf(ErrorWindow, err)
+	}
+
+	return windowRect(res)
+}
+
+func windowRect(res *HttpResponse) (*data.Rect, error) {
+	defer res.Body.Close()
+
+	reply := new(struct{ Value *data.Rect })
+	if err := unmarshalRes(&res.Response, reply); err != nil {
+		return nil, fmt.Errorf(ErrorWindow, err)
+	}
+
+	return reply.Value, nil
+}
+
+func (c *WebClient) SwitchFrame(id interface{}, sessionId string) error {
+	return c.SwitchFrameContext(context.Background(), id, sessionId)
+}
+
+// SwitchFrameContext
+// switches to frame of current browsing context
+// id is frame index, element reference or nil for top-level document
+func (c *WebClient) SwitchFrameContext(ctx context.Context, id interface{}, sessionId string) error {
+	b := marshalData(map[string]interface{}{"id": id})
+	p := fmt.Sprintf(frameEndpoint, c.WebConfig.WebServerAddr, sessionId)
+
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(b))
+	if err != nil {
+		return fmt.Errorf(ErrorFrame, err)
+	}
+
+	defer res.Body.Close()
+
+	return nil
+}
+
+func (c *WebClient) ParentFrame(sessionId string) error {
+	return c.ParentFrameContext(context.Background(), sessionId)
+}
+
+func (c *WebClient) ParentFrameContext(ctx context.Context, sessionId string) error {
+	b := marshalData(&data.Empty{})
+	p := fmt.Sprintf(parentFrameEndpoint, c.WebConfig.WebServerAddr, sessionId)
+
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(b))
+	if err != nil {
+		return fmt.Errorf(ErrorFrame, err)
+	}
+
+	defer res.Body.Close()
+
+	return nil
+}
+
+func (c *WebClient) AlertText(sessionId string) (string, error) {
+	return c.AlertTextContext(context.Background(), sessionId)
+}
 
-	res, err := c.Post(p, bytes.NewBuffer(b))
+// AlertTextContext
+// returns message of opened alert, confirm or prompt
+func (c *WebClient) AlertTextContext(ctx context.Context, sessionId string) (string, error) {
+	p := fmt.Sprintf(alertTextEndpoint, c.WebConfig.WebServerAddr, sessionId)
+	res, err := c.GetContext(ctx, p)
 	if err != nil {
-		return nil, fmt.Errorf(ErrorOpenUrl, err)
+		return "", fmt.Errorf(ErrorAlert, err)
 	}
 
 	defer res.Body.Close()
@@ -271,18 +854,45 @@ func (c *WebClient) Open(url, sessionId string) (*data.Url, error) {
 	reply := new(struct{ Value string })
 	unmarshalRes(&res.Response, reply)
 
-	return &data.Url{
-		Url: reply.Value,
-	}, nil
+	return reply.Value, nil
 }
 
-func (c *WebClient) NewTab(sessionId string) error {
-	b := marshalData(&data.Empty{})
-	p := fmt.Sprintf(newWindowEndpoint, c.WebConfig.WebServerAddr, sessionId)
+func (c *WebClient) AcceptAlert(sessionId string) error {
+	return c.AcceptAlertContext(context.Background(), sessionId)
+}
+
+func (c *WebClient) AcceptAlertContext(ctx context.Context, sessionId string) error {
+	return c.alert(ctx, acceptAlertEndpoint, &data.Empty{}, sessionId)
+}
 
-	res, err := c.Post(p, bytes.NewBuffer(b))
+func (c *WebClient) DismissAlert(sessionId string) error {
+	return c.DismissAlertContext(context.Background(), sessionId)
+}
+
+func (c *WebClient) DismissAlertContext(ctx context.Context, sessionId string) error {
+	return c.alert(ctx, dismissAlertEndpoint, &data.Empty{}, sessionId)
+}
+
+func (c *WebClient) SendAlertText(text, sessionId string) error {
+	return c.SendAlertTextContext(context.Background(), text, sessionId)
+}
+
+// SendAlertTextContext
+// types text into opened prompt
+// prompt is not accepted
+func (c *WebClient) SendAlertTextContext(ctx context.Context, text, sessionId string) error {
+	return c.alert(ctx, alertTextEndpoint, map[string]string{"text": text}, sessionId)
+}
+
+// alert
+// posts body to user prompt endpoint
+func (c *WebClient) alert(ctx context.Context, endpoint string, body interface{}, sessionId string) error {
+	b := marshalData(body)
+	p := fmt.Sprintf(endpoint, c.WebConfig.WebServerAddr, sessionId)
+
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(b))
 	if err != nil {
-		return fmt.Errorf(ErrorTab, err)
+		return fmt.Errorf(ErrorAlert, err)
 	}
 
 	defer res.Body.Close()
@@ -291,10 +901,14 @@ func (c *WebClient) NewTab(sessionId string) error {
 }
 
 func (c *WebClient) Tabs(sessionId string) ([]string, error) {
+	return c.TabsContext(context.Background(), sessionId)
+}
+
+func (c *WebClient) TabsContext(ctx context.Context, sessionId string) ([]string, error) {
 	h := new(struct{ Value []string })
 	url := fmt.Sprintf(windowHandlesEndpoint, c.WebConfig.WebServerAddr, sessionId)
 
-	res, err := c.Get(url)
+	res, err := c.GetContext(ctx, url)
 	if err != nil {
 		return nil, fmt.Errorf(ErrorTab, err)
 	}
@@ -307,33 +921,41 @@ func (c *WebClient) Tabs(sessionId string) ([]string, error) {
 }
 
 func (c *WebClient) Tab(n int, sessionId string) error {
-	tabs, err := c.Tabs(sessionId)
+	return c.TabContext(context.Background(), n, sessionId)
+}
+
+func (c *WebClient) TabContext(ctx context.Context, n int, sessionId string) error {
+	tabs, err := c.TabsContext(ctx, sessionId)
 	if err != nil {
 		return fmt.Errorf(ErrorTab, err)
 	}
 
-	tab := marshalData(map[string]string{"handle": tabs[n]})
-	url := fmt.Sprintf(windowEndpoint, c.WebConfig.WebServerAddr, sessionId)
+	if n < 0 || n >= len(tabs) {
+		return fmt.Errorf(ErrorTab, fmt.Errorf("tab %d out of %d tabs: %w", n, len(tabs), ErrNoSuchWindow))
+	}
 
-	res, err := c.Post(url, bytes.NewReader(tab))
-	if err != nil {
+	if err := c.SwitchWindowContext(ctx, tabs[n], sessionId); err != nil {
 		return fmt.Errorf(ErrorTab, err)
 	}
 
-	defer res.Body.Close()
 	return nil
 }
 
 func (c *WebClient) FindElement(selector *data.Selector, sessionId string) (string, error) {
+	return c.FindElementContext(context.Background(), selector, sessionId)
+}
+
+func (c *WebClient) FindElementContext(ctx context.Context, selector *data.Selector, sessionId string) (string, error) {
 	body := marshalData(&data.JsonFindUsing{
 		Using: selector.Using,
 		Value: selector.Value,
 	})
 
 	p := fmt.Sprintf(findElementEndpoint, c.WebConfig.WebServerAddr, sessionId)
-	res, err := c.Post(p, bytes.NewBuffer(body))
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(body))
 	if err != nil {
-		return "", fmt.Errorf(ErrorFindElement, err)
+		c.screenshotOnFail(ctx, sessionId)
+		return "", fmt.Errorf(ErrorFindElement, selector.Value, err)
 	}
 
 	defer res.Body.Close()
@@ -343,9 +965,7 @@ func (c *WebClient) FindElement(selector *data.Selector, sessionId string) (stri
 	unmarshalRes(&res.Response, reply)
 	eId, err := ElementID(reply.Value)
 	if err != nil {
-		if c.WebConfig.ScreenshotOnFail {
-			c.Screenshot(sessionId)
-		}
+		c.screenshotOnFail(ctx, sessionId)
 		return "", fmt.Errorf(ErrorElementId, reply.Value, err)
 	}
 
@@ -353,15 +973,20 @@ func (c *WebClient) FindElement(selector *data.Selector, sessionId string) (stri
 }
 
 func (c *WebClient) FindElements(selector *data.Selector, sessionId string) ([]string, error) {
+	return c.FindElementsContext(context.Background(), selector, sessionId)
+}
+
+func (c *WebClient) FindElementsContext(ctx context.Context, selector *data.Selector, sessionId string) ([]string, error) {
 	body := marshalData(&data.JsonFindUsing{
 		Using: selector.Using,
 		Value: selector.Value,
 	})
 
 	p := fmt.Sprintf(findElementsEndpoint, c.WebConfig.WebServerAddr, sessionId)
-	res, err := c.Post(p, bytes.NewBuffer(body))
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(body))
 	if err != nil {
-		return nil, fmt.Errorf(ErrorFindElement, err)
+		c.screenshotOnFail(ctx, sessionId)
+		return nil, fmt.Errorf(ErrorFindElement, selector.Value, err)
 	}
 
 	defer res.Body.Close()
@@ -371,9 +996,7 @@ func (c *WebClient) FindElements(selector *data.Selector, sessionId string) ([]s
 	unmarshalRes(&res.Response, reply)
 	eId, err := ElementsID(reply.Value)
 	if err != nil {
-		if c.WebConfig.ScreenshotOnFail {
-			c.Screenshot(sessionId)
-		}
+		c.screenshotOnFail(ctx, sessionId)
 		return nil, fmt.Errorf(ErrorElementId, reply.Value, err)
 	}
 
@@ -381,15 +1004,20 @@ func (c *WebClient) FindElements(selector *data.Selector, sessionId string) ([]s
 }
 
 func (c *WebClient) FromElements(selector *data.Selector, sessionId, elementId string) ([]string, error) {
+	return c.FromElementsContext(context.Background(), selector, sessionId, elementId)
+}
+
+func (c *WebClient) FromElementsContext(ctx context.Context, selector *data.Selector, sessionId, elementId string) ([]string, error) {
 	body := marshalData(&data.JsonFindUsing{
 		Using: selector.Using,
 		Value: selector.Value,
 	})
 
 	p := fmt.Sprintf(fromElementsEndpoint, c.WebConfig.WebServerAddr, sessionId, elementId)
-	res, err := c.Post(p, bytes.NewBuffer(body))
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(body))
 	if err != nil {
-		return nil, fmt.Errorf(ErrorFindElement, err)
+		c.screenshotOnFail(ctx, sessionId)
+		return nil, fmt.Errorf(ErrorFindElement, selector.Value, err)
 	}
 
 	defer res.Body.Close()
@@ -399,9 +1027,7 @@ func (c *WebClient) FromElements(selector *data.Selector, sessionId, elementId s
 	unmarshalRes(&res.Response, reply)
 	eId, err := ElementsID(reply.Value)
 	if err != nil {
-		if c.WebConfig.ScreenshotOnFail {
-			c.Screenshot(sessionId)
-		}
+		c.screenshotOnFail(ctx, sessionId)
 		return nil, fmt.Errorf(ErrorElementId, reply.Value, err)
 	}
 
@@ -409,15 +1035,80 @@ func (c *WebClient) FromElements(selector *data.Selector, sessionId, elementId s
 }
 
 func (c *WebClient) FromElement(selector *data.Selector, sessionId, elementId string) (string, error) {
+	return c.FromElementContext(context.Background(), selector, sessionId, elementId)
+}
+
+func (c *WebClient) FromElementContext(ctx context.Context, selector *data.Selector, sessionId, elementId string) (string, error) {
 	body := marshalData(&data.JsonFindUsing{
 		Using: selector.Using,
 		Value: selector.Value,
 	})
 
 	p := fmt.Sprintf(fromElementEndpoint, c.WebConfig.WebServerAddr, sessionId, elementId)
-	res, err := c.Post(p, bytes.NewBuffer(body))
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(body))
 	if err != nil {
-		return "", fmt.Errorf(ErrorFindElement, err)
+		c.screenshotOnFail(ctx, sessionId)
+		return "", fmt.Errorf(ErrorFindElement, selector.Value, err)
+	}
+
+	defer res.Body.Close()
+
+	reply := new(struct{ Value map[string]string })
+
+	unmarshalRes(&res.Response, reply)
+	eId, err := ElementID(reply.Value)
+	if err != nil {
+		c.screenshotOnFail(ctx, sessionId)
+		return "", fmt.Errorf(ErrorElementId, reply.Value, err)
+	}
+
+	return eId, nil
+}
+
+func (c *WebClient) ShadowRoot(sessionId, elementId string) (string, error) {
+	return c.ShadowRootContext(context.Background(), sessionId, elementId)
+}
+
+// ShadowRootContext
+// returns shadow root id of host element
+func (c *WebClient) ShadowRootContext(ctx context.Context, sessionId, elementId string) (string, error) {
+	p := fmt.Sprintf(shadowRootEndpoint, c.WebConfig.WebServerAddr, sessionId, elementId)
+	res, err := c.GetContext(ctx, p)
+	if err != nil {
+		return "", fmt.Errorf(ErrorShadowRoot, err)
+	}
+
+	defer res.Body.Close()
+
+	reply := new(struct{ Value map[string]string })
+	unmarshalRes(&res.Response, reply)
+
+	id, ok := reply.Value[config.ShadowRootIdentifier]
+	if !ok || id == "" {
+		return "", fmt.Errorf(ErrorShadowRoot, ErrMissingElementId)
+	}
+
+	return id, nil
+}
+
+func (c *WebClient) FromShadowElement(selector *data.Selector, sessionId, shadowId string) (string, error) {
+	return c.FromShadowElementContext(context.Background(), selector, sessionId, shadowId)
+}
+
+// FromShadowElementContext
+// finds element in shadow root
+// css selector, tag name and link text strategies are supported
+func (c *WebClient) FromShadowElementContext(ctx context.Context, selector *data.Selector, sessionId, shadowId string) (string, error) {
+	body := marshalData(&data.JsonFindUsing{
+		Using: selector.Using,
+		Value: selector.Value,
+	})
+
+	p := fmt.Sprintf(fromShadowElementEndpoint, c.WebConfig.WebServerAddr, sessionId, shadowId)
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(body))
+	if err != nil {
+		c.screenshotOnFail(ctx, sessionId)
+		return "", fmt.Errorf(ErrorFindElement, selector.Value, err)
 	}
 
 	defer res.Body.Close()
@@ -427,25 +1118,55 @@ func (c *WebClient) FromElement(selector *data.Selector, sessionId, elementId st
 	unmarshalRes(&res.Response, reply)
 	eId, err := ElementID(reply.Value)
 	if err != nil {
-		if c.WebConfig.ScreenshotOnFail {
-			c.Screenshot(sessionId)
-		}
 		return "", fmt.Errorf(ErrorElementId, reply.Value, err)
 	}
 
 	return eId, nil
 }
 
+func (c *WebClient) FromShadowElements(selector *data.Selector, sessionId, shadowId string) ([]string, error) {
+	return c.FromShadowElementsContext(context.Background(), selector, sessionId, shadowId)
+}
+
+func (c *WebClient) FromShadowElementsContext(ctx context.Context, selector *data.Selector, sessionId, shadowId string) ([]string, error) {
+	body := marshalData(&data.JsonFindUsing{
+		Using: selector.Using,
+		Value: selector.Value,
+	})
+
+	p := fmt.Sprintf(fromShadowElementsEndpoint, c.WebConfig.WebServerAddr, sessionId, shadowId)
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf(ErrorFindElement, selector.Value, err)
+	}
+
+	defer res.Body.Close()
+
+	reply := new(struct{ Value []map[string]string })
+
+	unmarshalRes(&res.Response, reply)
+	eIds, err := ElementsID(reply.Value)
+	if err != nil {
+		return nil, fmt.Errorf(ErrorElementId, reply.Value, err)
+	}
+
+	return eIds, nil
+}
+
 func (c *WebClient) TryFind(selector *data.Selector, sessionId string) (string, error) {
+	return c.TryFindContext(context.Background(), selector, sessionId)
+}
+
+func (c *WebClient) TryFindContext(ctx context.Context, selector *data.Selector, sessionId string) (string, error) {
 	body := marshalData(&data.JsonFindUsing{
 		Using: selector.Using,
 		Value: selector.Value,
 	})
 
 	p := fmt.Sprintf(findElementEndpoint, c.WebConfig.WebServerAddr, sessionId)
-	res, err := c.Post(p, bytes.NewBuffer(body))
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(body))
 	if err != nil {
-		return "", fmt.Errorf(ErrorFindElement, err)
+		return "", fmt.Errorf(ErrorFindElement, selector.Value, err)
 	}
 
 	defer res.Body.Close()
@@ -455,16 +1176,20 @@ func (c *WebClient) TryFind(selector *data.Selector, sessionId string) (string,
 	unmarshalRes(&res.Response, reply)
 	eId, err := ElementID(reply.Value)
 	if err != nil {
-		return "", fmt.Errorf(ErrorFindElement, reply.Value, err)
+		return "", fmt.Errorf(ErrorFindElement, selector.Value, err)
 	}
 
 	return eId, nil
 }
 
 func (c *WebClient) Status() (*data.DriverStatus, error) {
+	return c.StatusContext(context.Background())
+}
+
+func (c *WebClient) StatusContext(ctx context.Context) (*data.DriverStatus, error) {
 	url := fmt.Sprintf(statusEndpoint, c.WebConfig.WebServerAddr)
 
-	res, err := c.Get(url)
+	res, err := c.GetContext(ctx, url)
 	if err != nil {
 		return nil, fmt.Errorf(ErrorStatus, err)
 	}
@@ -478,10 +1203,14 @@ func (c *WebClient) Status() (*data.DriverStatus, error) {
 }
 
 func (c *WebClient) Session(caps *capabilities.Capabilities) (*data.Session, error) {
+	return c.SessionContext(context.Background(), caps)
+}
+
+func (c *WebClient) SessionContext(ctx context.Context, caps *capabilities.Capabilities) (*data.Session, error) {
 	d := marshalData(caps)
 
 	url := fmt.Sprintf(sessionEndpoint, c.WebConfig.WebServerAddr)
-	res, err := c.Post(url, bytes.NewBuffer(d))
+	res, err := c.PostContext(ctx, url, bytes.NewBuffer(d))
 	if err != nil {
 		return nil, fmt.Errorf(ErrorCreateSession, err)
 	}
@@ -491,14 +1220,16 @@ func (c *WebClient) Session(caps *capabilities.Capabilities) (*data.Session, err
 	reply := new(struct{ Value data.Session })
 	unmarshalRes(&res.Response, reply)
 
-	return &data.Session{
-		Id: reply.Value.Id,
-	}, nil
+	return &reply.Value, nil
 }
 
 func (c *WebClient) Quit(sessionId string) error {
+	return c.QuitContext(context.Background(), sessionId)
+}
+
+func (c *WebClient) QuitContext(ctx context.Context, sessionId string) error {
 	url := fmt.Sprintf(quitEndpoint, c.WebConfig.WebServerAddr, sessionId)
-	res, err := c.Delete(url)
+	res, err := c.DeleteContext(ctx, url)
 	if err != nil {
 		return fmt.Errorf(ErrorDeleteSession, err)
 	}
@@ -508,8 +1239,12 @@ func (c *WebClient) Quit(sessionId string) error {
 }
 
 func (c *WebClient) IsDisplayed(sessionId, elementId string) (bool, error) {
+	return c.IsDisplayedContext(context.Background(), sessionId, elementId)
+}
+
+func (c *WebClient) IsDisplayedContext(ctx context.Context, sessionId, elementId string) (bool, error) {
 	p := fmt.Sprintf(isDisplayedEndpoint, c.WebConfig.WebServerAddr, sessionId, elementId)
-	res, err := c.Get(p)
+	res, err := c.GetContext(ctx, p)
 	if err != nil {
 		return false, fmt.Errorf(ErrorDisplayedElement, err)
 	}
@@ -523,12 +1258,14 @@ func (c *WebClient) IsDisplayed(sessionId, elementId string) (bool, error) {
 }
 
 func (c *WebClient) Is(sessionId, elementId string) (bool, error) {
+	return c.IsContext(context.Background(), sessionId, elementId)
+}
+
+func (c *WebClient) IsContext(ctx context.Context, sessionId, elementId string) (bool, error) {
 	p := fmt.Sprintf(isEndpoint, c.WebConfig.WebServerAddr, sessionId, elementId)
-	res, err := c.Get(p)
+	res, err := c.GetContext(ctx, p)
 	if err != nil {
-		if c.WebConfig.ScreenshotOnFail {
-			c.Screenshot(sessionId)
-		}
+		c.screenshotOnFail(ctx, sessionId)
 		return false, fmt.Errorf(ErrorDisplayedElement, err)
 	}
 
@@ -541,9 +1278,13 @@ func (c *WebClient) Is(sessionId, elementId string) (bool, error) {
 }
 
 func (c *WebClient) Click(sessionId, elementId string) error {
+	return c.ClickContext(context.Background(), sessionId, elementId)
+}
+
+func (c *WebClient) ClickContext(ctx context.Context, sessionId, elementId string) error {
 	p := fmt.Sprintf(clickEndpoint, c.WebConfig.WebServerAddr, sessionId, elementId)
 	d := marshalData(data.Empty{})
-	res, err := c.Post(p, bytes.NewBuffer(d))
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(d))
 	if err != nil {
 		return fmt.Errorf(ErrorClick, err)
 	}
@@ -553,12 +1294,36 @@ func (c *WebClient) Click(sessionId, elementId string) error {
 	return nil
 }
 
+// Clear
+// resets input, textarea or contenteditable element
+// driver fires change event
+func (c *WebClient) Clear(sessionId, elementId string) error {
+	return c.ClearContext(context.Background(), sessionId, elementId)
+}
+
+func (c *WebClient) ClearContext(ctx context.Context, sessionId, elementId string) error {
+	p := fmt.Sprintf(clearEndpoint, c.WebConfig.WebServerAddr, sessionId, elementId)
+	d := marshalData(data.Empty{})
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(d))
+	if err != nil {
+		return fmt.Errorf(ErrorClear, err)
+	}
+
+	defer res.Body.Close()
+
+	return nil
+}
+
 func (c *WebClient) Input(keys, sessionId, elementId string) error {
+	return c.InputContext(context.Background(), keys, sessionId, elementId)
+}
+
+func (c *WebClient) InputContext(ctx context.Context, keys, sessionId, elementId string) error {
 	p := fmt.Sprintf(sendKeysEndpoint, c.WebConfig.WebServerAddr, sessionId, elementId)
 	d := marshalData(data.SendKeys{
 		Text: keys,
 	})
-	res, err := c.Post(p, bytes.NewBuffer(d))
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(d))
 	if err != nil {
 		return fmt.Errorf(ErrorSendKeys, err)
 	}
@@ -569,8 +1334,12 @@ func (c *WebClient) Input(keys, sessionId, elementId string) error {
 }
 
 func (c *WebClient) Attr(attr, sessionId, elementId string) (string, error) {
+	return c.AttrContext(context.Background(), attr, sessionId, elementId)
+}
+
+func (c *WebClient) AttrContext(ctx context.Context, attr, sessionId, elementId string) (string, error) {
 	p := fmt.Sprintf(attributeEndpoint, c.WebConfig.WebServerAddr, sessionId, elementId, attr)
-	res, err := c.Get(p)
+	res, err := c.GetContext(ctx, p)
 	if err != nil {
 		return "", fmt.Errorf(ErrorAttribute, err)
 	}
@@ -583,7 +1352,185 @@ func (c *WebClient) Attr(attr, sessionId, elementId string) (string, error) {
 	return reply.Value, nil
 }
 
+func (c *WebClient) Property(name, sessionId, elementId string) (interface{}, error) {
+	return c.PropertyContext(context.Background(), name, sessionId, elementId)
+}
+
+// PropertyContext
+// returns element DOM property
+// i.e. value, checked, or nil if not defined
+func (c *WebClient) PropertyContext(ctx context.Context, name, sessionId, elementId string) (interface{}, error) {
+	reply := new(struct{ Value interface{} })
+	p := fmt.Sprintf(propertyEndpoint, c.WebConfig.WebServerAddr, sessionId, elementId, url.PathEscape(name))
+
+	err := c.elementState(ctx, p, reply)
+	return reply.Value, err
+}
+
+func (c *WebClient) CSSValue(name, sessionId, elementId string) (string, error) {
+	return c.CSSValueContext(context.Background(), name, sessionId, elementId)
+}
+
+// CSSValueContext
+// returns computed css property value
+func (c *WebClient) CSSValueContext(ctx context.Context, name, sessionId, elementId string) (string, error) {
+	reply := new(struct{ Value string })
+	p := fmt.Sprintf(cssValueEndpoint, c.WebConfig.WebServerAddr, sessionId, elementId, url.PathEscape(name))
+
+	err := c.elementState(ctx, p, reply)
+	return reply.Value, err
+}
+
+func (c *WebClient) TagName(sessionId, elementId string) (string, error) {
+	return c.TagNameContext(context.Background(), sessionId, elementId)
+}
+
+func (c *WebClient) TagNameContext(ctx context.Context, sessionId, elementId string) (string, error) {
+	reply := new(struct{ Value string })
+	p := fmt.Sprintf(tagNameEndpoint, c.WebConfig.WebServerAddr, sessionId, elementId)
+
+	err := c.elementState(ctx, p, reply)
+	return reply.Value, err
+}
+
+func (c *WebClient) ElementRect(sessionId, elementId string) (*data.Rect, error) {
+	return c.ElementRectContext(context.Background(), sessionId, elementId)
+}
+
+// ElementRectContext
+// returns element position and size
+// relative to top-left corner of the document
+func (c *WebClient) ElementRectContext(ctx context.Context, sessionId, elementId string) (*data.Rect, error) {
+	reply := new(struct{ Value *data.Rect })
+	p := fmt.Sprintf(elementRectEndpoint, c.WebConfig.WebServerAddr, sessionId, elementId)
+
+	err := c.elementState(ctx, p, reply)
+	return reply.Value, err
+}
+
+func (c *WebClient) IsEnabled(sessionId, elementId string) (bool, error) {
+	return c.IsEnabledContext(context.Background(), sessionId, elementId)
+}
+
+func (c *WebClient) IsEnabledContext(ctx context.Context, sessionId, elementId string) (bool, error) {
+	reply := new(struct{ Value bool })
+	p := fmt.Sprintf(enabledEndpoint, c.WebConfig.WebServerAddr, sessionId, elementId)
+
+	err := c.elementState(ctx, p, reply)
+	return reply.Value, err
+}
+
+func (c *WebClient) IsSelected(sessionId, elementId string) (bool, error) {
+	return c.IsSelectedContext(context.Background(), sessionId, elementId)
+}
+
+// IsSelectedContext
+// checked state of checkbox, radio
+// or selected state of option
+func (c *WebClient) IsSelectedContext(ctx context.Context, sessionId, elementId string) (bool, error) {
+	reply := new(struct{ Value bool })
+	p := fmt.Sprintf(selectedEndpoint, c.WebConfig.WebServerAddr, sessionId, elementId)
+
+	err := c.elementState(ctx, p, reply)
+	return reply.Value, err
+}
+
+func (c *WebClient) ComputedRole(sessionId, elementId string) (string, error) {
+	return c.ComputedRoleContext(context.Background(), sessionId, elementId)
+}
+
+// ComputedRoleContext
+// returns element accessibility role
+func (c *WebClient) ComputedRoleContext(ctx context.Context, sessionId, elementId string) (string, error) {
+	reply := new(struct{ Value string })
+	p := fmt.Sprintf(computedRoleEndpoint, c.WebConfig.WebServerAddr, sessionId, elementId)
+
+	err := c.elementState(ctx, p, reply)
+	return reply.Value, err
+}
+
+func (c *WebClient) ComputedLabel(sessionId, elementId string) (string, error) {
+	return c.ComputedLabelContext(context.Background(), sessionId, elementId)
+}
+
+// ComputedLabelContext
+// returns element accessible name
+func (c *WebClient) ComputedLabelContext(ctx context.Context, sessionId, elementId string) (string, error) {
+	reply := new(struct{ Value string })
+	p := fmt.Sprintf(computedLabelEndpoint, c.WebConfig.WebServerAddr, sessionId, elementId)
+
+	err := c.elementState(ctx, p, reply)
+	return reply.Value, err
+}
+
+// elementState
+// gets element state endpoint
+// decodes response into reply
+func (c *WebClient) elementState(ctx context.Context, p string, reply interface{}) error {
+	res, err := c.GetContext(ctx, p)
+	if err != nil {
+		return fmt.Errorf(ErrorElementState, err)
+	}
+
+	defer res.Body.Close()
+
+	if err := unmarshalRes(&res.Response, reply); err != nil {
+		return fmt.Errorf(ErrorElementState, err)
+	}
+
+	return nil
+}
+
 func (c *WebClient) Script(script, sessionId string, args ...interface{}) error {
+	return c.ScriptContext(context.Background(), script, sessionId, args...)
+}
+
+func (c *WebClient) ScriptContext(ctx context.Context, script, sessionId string, args ...interface{}) error {
+	_, err := c.ScriptValueContext(ctx, script, sessionId, args...)
+	return err
+}
+
+func (c *WebClient) ScriptValue(script, sessionId string, args ...interface{}) (interface{}, error) {
+	return c.ScriptValueContext(context.Background(), script, sessionId, args...)
+}
+
+// ScriptValueContext
+// executes sync script
+// returns decoded script result
+func (c *WebClient) ScriptValueContext(ctx context.Context, script, sessionId string, args ...interface{}) (interface{}, error) {
+	return scriptValue(c.ScriptRawContext(ctx, script, sessionId, args...))
+}
+
+// ScriptRawContext
+// executes sync script
+// returns script result JSON
+func (c *WebClient) ScriptRawContext(ctx context.Context, script, sessionId string, args ...interface{}) (json.RawMessage, error) {
+	return c.script(ctx, syncScriptEndpoint, script, sessionId, args)
+}
+
+func (c *WebClient) AsyncScriptValue(script, sessionId string, args ...interface{}) (interface{}, error) {
+	return c.AsyncScriptValueContext(context.Background(), script, sessionId, args...)
+}
+
+// AsyncScriptValueContext
+// executes async script
+// script resolves with callback passed as last argument
+// returns decoded script result
+func (c *WebClient) AsyncScriptValueContext(ctx context.Context, script, sessionId string, args ...interface{}) (interface{}, error) {
+	return scriptValue(c.AsyncScriptRawContext(ctx, script, sessionId, args...))
+}
+
+// AsyncScriptRawContext
+// executes async script
+// returns script result JSON
+func (c *WebClient) AsyncScriptRawContext(ctx context.Context, script, sessionId string, args ...interface{}) (json.RawMessage, error) {
+	return c.script(ctx, asyncScriptEndpoint, script, sessionId, args)
+}
+
+// script
+// posts script and args to execute endpoint
+// element references in args are marshaled by caller
+func (c *WebClient) script(ctx context.Context, endpoint, script, sessionId string, args []interface{}) (json.RawMessage, error) {
 	if args == nil {
 		args = make([]interface{}, 0)
 	}
@@ -593,14 +1540,37 @@ func (c *WebClient) Script(script, sessionId string, args ...interface{}) error
 		"args":   args,
 	})
 
-	p := fmt.Sprintf(syncScriptEndpoint, c.WebConfig.WebServerAddr, sessionId)
-	res, err := c.Post(p, bytes.NewBuffer(body))
+	p := fmt.Sprintf(endpoint, c.WebConfig.WebServerAddr, sessionId)
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(body))
 	if err != nil {
-		return fmt.Errorf(ErrorScriptExecute, err)
+		return nil, fmt.Errorf(ErrorScriptExecute, err)
 	}
 
 	defer res.Body.Close()
-	return nil
+
+	reply := new(struct{ Value json.RawMessage })
+	if err := unmarshalRes(&res.Response, reply); err != nil {
+		return nil, fmt.Errorf(ErrorScriptExecute, err)
+	}
+
+	return reply.Value, nil
+}
+
+func scriptValue(raw json.RawMessage, err error) (interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+	if len(raw) == 0 {
+		return v, nil
+	}
+
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, fmt.Errorf(ErrorScriptExecute, err)
+	}
+
+	return v, nil
 }
 
 // randSeq
@@ -617,15 +1587,34 @@ func randSeq(n int) string {
 	return string(b)
 }
 
+// screenshotOnFail
+// takes screenshot if ScreenshotOnFail is set
+// skipped on NoWait, i.e. absence check expects miss
+func (c *WebClient) screenshotOnFail(ctx context.Context, sessionId string) {
+	if timeout, ok := WaitTimeout(ctx); ok && timeout == 0 {
+		return
+	}
+
+	if c.WebConfig.ScreenshotOnFail {
+		c.ScreenshotContext(ctx, sessionId)
+	}
+}
+
 func (c *WebClient) Screenshot(sessionId string) error {
+	return c.ScreenshotContext(context.Background(), sessionId)
+}
+
+func (c *WebClient) ScreenshotContext(ctx context.Context, sessionId string) error {
 	data := new(struct{ Value string })
 
 	p := fmt.Sprintf(screenshotEndpoint, c.WebConfig.WebServerAddr, sessionId)
-	res, err := c.Get(p)
+	res, err := c.GetContext(ctx, p)
 	if err != nil {
-		return fmt.Errorf("error on screenshot request: %v", err)
+		return fmt.Errorf("error on screenshot request: %w", err)
 	}
 
+	defer res.Body.Close()
+
 	unmarshalRes(&res.Response, data)
 
 	decodedImage, err := base64.StdEncoding.DecodeString(data.Value)
@@ -657,10 +1646,14 @@ func (c *WebClient) Screenshot(sessionId string) error {
 }
 
 func (c *WebClient) Active(sessionId string) (string, error) {
+	return c.ActiveContext(context.Background(), sessionId)
+}
+
+func (c *WebClient) ActiveContext(ctx context.Context, sessionId string) (string, error) {
 	p := fmt.Sprintf(activeEndpoint, c.WebConfig.WebServerAddr, sessionId)
-	res, err := c.Get(p)
+	res, err := c.GetContext(ctx, p)
 	if err != nil {
-		return "", fmt.Errorf(ErrorActiveElement, err)
+		return "", fmt.Errorf(ErrorActiveElement, "", err)
 	}
 
 	defer res.Body.Close()
@@ -670,9 +1663,7 @@ func (c *WebClient) Active(sessionId string) (string, error) {
 	unmarshalRes(&res.Response, reply)
 	eId, err := ElementID(reply.Value)
 	if err != nil {
-		if c.WebConfig.ScreenshotOnFail {
-			c.Screenshot(sessionId)
-		}
+		c.screenshotOnFail(ctx, sessionId)
 		return "", fmt.Errorf(ErrorActiveElement, reply.Value, err)
 	}
 
@@ -680,6 +1671,10 @@ func (c *WebClient) Active(sessionId string) (string, error) {
 }
 
 func (c *WebClient) Action(keys, action, sessionId string) error {
+	return c.ActionContext(context.Background(), keys, action, sessionId)
+}
+
+func (c *WebClient) ActionContext(ctx context.Context, keys, action, sessionId string) error {
 	actions := make([]data.KeyAction, 0, len(keys))
 
 	for _, key := range keys {
@@ -699,7 +1694,30 @@ func (c *WebClient) Action(keys, action, sessionId string) error {
 			}},
 	})
 
-	res, err := c.Post(p, bytes.NewReader(data))
+	res, err := c.PostContext(ctx, p, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf(ErrorAction, err)
+	}
+
+	defer res.Body.Close()
+
+	return nil
+}
+
+// PerformActions
+// sends input sources ticks to /actions
+// actions of the same tick are dispatched together
+func (c *WebClient) PerformActions(sources []data.ActionSource, sessionId string) error {
+	return c.PerformActionsContext(context.Background(), sources, sessionId)
+}
+
+func (c *WebClient) PerformActionsContext(ctx context.Context, sources []data.ActionSource, sessionId string) error {
+	p := fmt.Sprintf(actionEndpoint, c.WebConfig.WebServerAddr, sessionId)
+	d := marshalData(map[string]interface{}{
+		"actions": sources,
+	})
+
+	res, err := c.PostContext(ctx, p, bytes.NewReader(d))
 	if err != nil {
 		return fmt.Errorf(ErrorAction, err)
 	}
@@ -710,11 +1728,15 @@ func (c *WebClient) Action(keys, action, sessionId string) error {
 }
 
 func (c *WebClient) ReleaseAction(sessionId string) error {
+	return c.ReleaseActionContext(context.Background(), sessionId)
+}
+
+func (c *WebClient) ReleaseActionContext(ctx context.Context, sessionId string) error {
 	p := fmt.Sprintf(actionEndpoint, c.WebConfig.WebServerAddr, sessionId)
 
-	res, err := c.Delete(p)
+	res, err := c.DeleteContext(ctx, p)
 	if err != nil {
-		return fmt.Errorf(ErrorActiveElement, err)
+		return fmt.Errorf(ErrorAction, err)
 	}
 
 	defer res.Body.Close()
@@ -723,9 +1745,13 @@ func (c *WebClient) ReleaseAction(sessionId string) error {
 }
 
 func (c *WebClient) Text(sessionId, elementId string) (string, error) {
+	return c.TextContext(context.Background(), sessionId, elementId)
+}
+
+func (c *WebClient) TextContext(ctx context.Context, sessionId, elementId string) (string, error) {
 	p := fmt.Sprintf(textEndpoint, c.WebConfig.WebServerAddr, sessionId, elementId)
 
-	res, err := c.Get(p)
+	res, err := c.GetContext(ctx, p)
 	if err != nil {
 		return "", fmt.Errorf(ErrorTextElement, err)
 	}
@@ -0,0 +1,151 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+)
+
+// W3C error codes
+// src: https://www.w3.org/TR/webdriver2/#errors
+var (
+	ErrElementClickIntercepted = &WebDriverError{Code: "element click intercepted"}
+	ErrElementNotInteractable  = &WebDriverError{Code: "element not interactable"}
+	ErrInsecureCertificate     = &WebDriverError{Code: "insecure certificate"}
+	ErrInvalidArgument         = &WebDriverError{Code: "invalid argument"}
+	ErrInvalidCookieDomain     = &WebDriverError{Code: "invalid cookie domain"}
+	ErrInvalidElementState     = &WebDriverError{Code: "invalid element state"}
+	ErrInvalidSelector         = &WebDriverError{Code: "invalid selector"}
+	ErrInvalidSessionId        = &WebDriverError{Code: "invalid session id"}
+	ErrJavascript              = &WebDriverError{Code: "javascript error"}
+	ErrMoveTargetOutOfBounds   = &WebDriverError{Code: "move target out of bounds"}
+	ErrNoSuchAlert             = &WebDriverError{Code: "no such alert"}
+	ErrNoSuchCookie            = &WebDriverError{Code: "no such cookie"}
+	ErrNoSuchElement           = &WebDriverError{Code: "no such element"}
+	ErrNoSuchFrame             = &WebDriverError{Code: "no such frame"}
+	ErrNoSuchWindow            = &WebDriverError{Code: "no such window"}
+	ErrNoSuchShadowRoot        = &WebDriverError{Code: "no such shadow root"}
+	ErrScriptTimeout           = &WebDriverError{Code: "script timeout"}
+	ErrSessionNotCreated       = &WebDriverError{Code: "session not created"}
+	ErrStaleElementReference   = &WebDriverError{Code: "stale element reference"}
+	ErrDetachedShadowRoot      = &WebDriverError{Code: "detached shadow root"}
+	ErrTimeout                 = &WebDriverError{Code: "timeout"}
+	ErrUnableToSetCookie       = &WebDriverError{Code: "unable to set cookie"}
+	ErrUnableToCaptureScreen   = &WebDriverError{Code: "unable to capture screen"}
+	ErrUnexpectedAlertOpen     = &WebDriverError{Code: "unexpected alert open"}
+	ErrUnknownCommand          = &WebDriverError{Code: "unknown command"}
+	ErrUnknownError            = &WebDriverError{Code: "unknown error"}
+	ErrUnknownMethod           = &WebDriverError{Code: "unknown method"}
+	ErrUnsupportedOperation    = &WebDriverError{Code: "unsupported operation"}
+)
+
+// alertTextMessage
+// chromedriver unexpected alert open message
+var alertTextMessage = regexp.MustCompile(`(?s)\{Alert text : (.*)\}`)
+
+// WebDriverError
+// decoded W3C error response body
+// {"value": {"error": "", "message": "", "stacktrace": ""}}
+//
+// use errors.Is to match error code:
+//
+//	errors.Is(err, client.ErrNoSuchElement)
+//
+// and errors.As to access message or stacktrace
+type WebDriverError struct {
+	// Status
+	// http status code of the response
+	Status int `json:"-"`
+
+	Code       string                 `json:"error"`
+	Message    string                 `json:"message"`
+	Stacktrace string                 `json:"stacktrace"`
+	Data       map[string]interface{} `json:"data,omitempty"`
+}
+
+func (e *WebDriverError) Error() string {
+	if e.Message == "" {
+		return e.Code
+	}
+
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// AlertText
+// returns text of unexpected alert open error
+// from error data, as sent by geckodriver,
+// or message, as sent by chromedriver, i.e.
+// "unexpected alert open: {Alert text : Delete?}"
+func (e *WebDriverError) AlertText() string {
+	if text, ok := e.Data["text"].(string); ok {
+		return text
+	}
+
+	if m := alertTextMessage.FindStringSubmatch(e.Message); m != nil {
+		return m[1]
+	}
+
+	return ""
+}
+
+// Is
+// matches errors by W3C error code
+func (e *WebDriverError) Is(target error) bool {
+	t, ok := target.(*WebDriverError)
+	if !ok {
+		return false
+	}
+
+	return t.Code == e.Code
+}
+
+// decodeError
+// reads non-2xx response body into WebDriverError
+// unknown error code is used if body is not W3C error
+func decodeError(res *http.Response) error {
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error on read error response: %w", err)
+	}
+
+	reply := new(struct{ Value *WebDriverError })
+	if err := json.Unmarshal(body, reply); err != nil {
+		reply.Value = decodeErrorData(body)
+	}
+
+	if reply.Value == nil || reply.Value.Code == "" {
+		return &WebDriverError{
+			Status:  res.StatusCode,
+			Code:    ErrUnknownError.Code,
+			Message: fmt.Sprintf("%s: %s", res.Status, body),
+		}
+	}
+
+	reply.Value.Status = res.StatusCode
+	return reply.Value
+}
+
+// decodeErrorData
+// decodes error with non-object data,
+// i.e. unexpected alert open with alert text string
+// text is kept in Data["text"]
+func decodeErrorData(body []byte) *WebDriverError {
+	reply := new(struct {
+		Value struct {
+			WebDriverError
+			Data interface{} `json:"data"`
+		}
+	})
+	if err := json.Unmarshal(body, reply); err != nil {
+		return nil
+	}
+
+	e := reply.Value.WebDriverError
+	if text, ok := reply.Value.Data.(string); ok {
+		e.Data = map[string]interface{}{"text": text}
+	}
+
+	return &e
+}
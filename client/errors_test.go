@@ -0,0 +1,94 @@
+package client_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mcsymiv/gost/client"
+	"github.com/mcsymiv/gost/config"
+	"github.com/mcsymiv/gost/data"
+)
+
+func TestWebDriverError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(config.ContenType, config.ApplicationJson)
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"value":{"error":"no such element","message":"Unable to locate element: #missing","stacktrace":"trace"}}`))
+	}))
+	defer srv.Close()
+
+	config.Config = config.DefaultConfig()
+	config.Config.WebServerAddr = srv.URL
+	config.Config.ScreenshotOnFail = false
+
+	cl := client.NewClient()
+	_, err := cl.FindElement(&data.Selector{Using: data.ByCssSelector, Value: "#missing"}, "session")
+	if !errors.Is(err, client.ErrNoSuchElement) {
+		t.Fatalf("expected no such element, got: %v", err)
+	}
+
+	if errors.Is(err, client.ErrStaleElementReference) {
+		t.Errorf("unexpected stale element reference match: %v", err)
+	}
+
+	var wdErr *client.WebDriverError
+	if !errors.As(err, &wdErr) {
+		t.Fatalf("expected WebDriverError, got: %T", err)
+	}
+
+	if wdErr.Status != http.StatusNotFound || wdErr.Message != "Unable to locate element: #missing" || wdErr.Stacktrace != "trace" {
+		t.Errorf("unexpected decoded error: %+v", wdErr)
+	}
+}
+
+func TestWebDriverErrorUnknownBody(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("bad gateway"))
+	}))
+	defer srv.Close()
+
+	config.Config = config.DefaultConfig()
+	config.Config.WebServerAddr = srv.URL
+
+	cl := client.NewClient()
+	err := cl.Click("session", "element")
+	if !errors.Is(err, client.ErrUnknownError) {
+		t.Fatalf("expected unknown error, got: %v", err)
+	}
+}
+
+func TestWebDriverErrorAlertText(t *testing.T) {
+	bodies := []string{
+		`{"value":{"error":"unexpected alert open","message":"Dismissed user prompt dialog: Delete?","data":{"text":"Delete?"}}}`,
+		`{"value":{"error":"unexpected alert open","message":"unexpected alert open","data":"Delete?"}}`,
+		`{"value":{"error":"unexpected alert open","message":"unexpected alert open: {Alert text : Delete?}"}}`,
+	}
+
+	for _, body := range bodies {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(config.ContenType, config.ApplicationJson)
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(body))
+		}))
+
+		config.Config = config.DefaultConfig()
+		config.Config.WebServerAddr = srv.URL
+
+		cl := client.NewClient()
+		err := cl.Click("session", "element")
+		srv.Close()
+
+		var wdErr *client.WebDriverError
+		if !errors.Is(err, client.ErrUnexpectedAlertOpen) || !errors.As(err, &wdErr) {
+			t.Errorf("expected unexpected alert open, got: %v", err)
+			continue
+		}
+
+		if text := wdErr.AlertText(); text != "Delete?" {
+			t.Errorf("expected alert text from %s, got: %q", body, text)
+		}
+	}
+}
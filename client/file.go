@@ -0,0 +1,103 @@
+package client
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mcsymiv/gost/data"
+)
+
+// RemoteDriver
+// reports if WebDriverAddr is not on local host
+// remote driver can't read local files
+// they are uploaded with UploadFile first
+func (c *WebClient) RemoteDriver() bool {
+	addr := c.WebConfig.WebDriverAddr
+	if !strings.Contains(addr, "://") {
+		addr = "http://" + addr
+	}
+
+	u, err := url.Parse(addr)
+	if err != nil {
+		return false
+	}
+
+	host := u.Hostname()
+	if host == "" || strings.EqualFold(host, "localhost") {
+		return false
+	}
+
+	ip := net.ParseIP(host)
+	return ip == nil || !ip.IsLoopback()
+}
+
+// UploadFile
+// zips local file and sends it to driver file endpoint
+// returns file path on driver host
+// to be typed into <input type=file>
+func (c *WebClient) UploadFile(path, sessionId string) (string, error) {
+	return c.UploadFileContext(context.Background(), path, sessionId)
+}
+
+func (c *WebClient) UploadFileContext(ctx context.Context, path, sessionId string) (string, error) {
+	b64, err := zipFile(path)
+	if err != nil {
+		return "", fmt.Errorf(ErrorUploadFile, err)
+	}
+
+	p := fmt.Sprintf(uploadFileEndpoint, c.WebConfig.WebServerAddr, sessionId)
+	d := marshalData(data.UploadFile{
+		File: b64,
+	})
+	res, err := c.PostContext(ctx, p, bytes.NewBuffer(d))
+	if err != nil {
+		return "", fmt.Errorf(ErrorUploadFile, err)
+	}
+
+	defer res.Body.Close()
+
+	reply := new(struct{ Value string })
+	if err := unmarshalRes(&res.Response, reply); err != nil {
+		return "", fmt.Errorf(ErrorUploadFile, err)
+	}
+
+	return reply.Value, nil
+}
+
+// zipFile
+// base64 zip archive with single file entry
+// named as file base name
+func zipFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+
+	w, err := zw.Create(filepath.Base(path))
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(w, f); err != nil {
+		return "", err
+	}
+
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
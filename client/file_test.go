@@ -0,0 +1,29 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/mcsymiv/gost/client"
+	"github.com/mcsymiv/gost/config"
+)
+
+func TestRemoteDriver(t *testing.T) {
+	addrs := map[string]bool{
+		"http://localhost:4444":    false,
+		"http://127.0.0.1:4444":    false,
+		"http://[::1]:4444":        false,
+		"localhost:4444":           false,
+		"http://grid.test:4444":    true,
+		"https://10.0.0.5:4444/wd": true,
+		"selenium:4444":            true,
+	}
+
+	for addr, remote := range addrs {
+		config.Config = config.DefaultConfig()
+		config.Config.WebDriverAddr = addr
+
+		if got := client.NewClient().RemoteDriver(); got != remote {
+			t.Errorf("expected remote %v for %q, got: %v", remote, addr, got)
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// screenshotLimit
+// number of base64 screenshot and upload file characters kept in trace
+const screenshotLimit = 64
+
+// TraceRecord
+// single WebDriver command written as JSON line
+type TraceRecord struct {
+	Time time.Time `json:"time"`
+
+	// Endpoint
+	// path template, i.e. /session/{id}/element/{id}/click
+	Endpoint string `json:"endpoint"`
+	Method   string `json:"method"`
+	Path     string `json:"path"`
+	Attempt  int    `json:"attempt"`
+
+	Request  json.RawMessage `json:"request,omitempty"`
+	Status   int             `json:"status"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    string          `json:"error,omitempty"`
+
+	// Duration
+	// command round trip in milliseconds
+	Duration float64 `json:"duration"`
+}
+
+// tracer
+// http.RoundTrip client middleware
+// records requests and responses as JSON lines
+// passes requests through if no writer is set
+type tracer struct {
+	next http.RoundTripper
+
+	mu   sync.Mutex
+	w    io.Writer
+	file *os.File
+}
+
+func (t *tracer) writer() io.Writer {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.w
+}
+
+// RoundTrip
+// middleware tracer for Client
+func (t *tracer) RoundTrip(r *http.Request) (*http.Response, error) {
+	if t.writer() == nil {
+		return t.next.RoundTrip(r)
+	}
+
+	rec := &TraceRecord{
+		Time:     time.Now(),
+		Endpoint: Endpoint(r.URL.Path),
+		Method:   r.Method,
+		Path:     r.URL.Path,
+		Attempt:  attempt(r.Context()),
+	}
+
+	if r.Body != nil && r.Body != http.NoBody {
+		b, err := io.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error on read trace request body: %w", err)
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(b))
+
+		if strings.HasSuffix(rec.Endpoint, "/se/file") {
+			b = truncateFile(b)
+		}
+
+		rec.Request = rawJSON(b)
+	}
+
+	res, err := t.next.RoundTrip(r)
+	rec.Duration = float64(time.Since(rec.Time).Microseconds()) / 1000
+
+	if err != nil {
+		rec.Error = err.Error()
+		t.write(rec)
+		return res, err
+	}
+
+	b, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error on read trace response body: %w", err)
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(b))
+
+	if strings.HasSuffix(rec.Endpoint, "/screenshot") {
+		b = truncateScreenshot(b)
+	}
+
+	rec.Status = res.StatusCode
+	rec.Response = rawJSON(b)
+	t.write(rec)
+
+	return res, nil
+}
+
+func (t *tracer) write(rec *TraceRecord) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.w == nil {
+		return
+	}
+
+	json.NewEncoder(t.w).Encode(rec)
+}
+
+// Endpoint
+// replaces session, element and shadow root ids
+// in W3C path with {id}
+func Endpoint(path string) string {
+	parts := strings.Split(path, "/")
+
+	for i := 1; i < len(parts); i++ {
+		switch parts[i-1] {
+		case "session", "shadow":
+			parts[i] = "{id}"
+		case "element":
+			if parts[i] != "active" {
+				parts[i] = "{id}"
+			}
+		}
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// rawJSON
+// keeps valid JSON body as is
+// otherwise stores body as JSON string
+func rawJSON(b []byte) json.RawMessage {
+	if len(b) == 0 {
+		return nil
+	}
+
+	if json.Valid(b) {
+		return json.RawMessage(b)
+	}
+
+	s, _ := json.Marshal(string(b))
+	return json.RawMessage(s)
+}
+
+// truncateScreenshot
+// shortens base64 image value in screenshot response
+func truncateScreenshot(b []byte) []byte {
+	reply := new(struct {
+		Value string `json:"value"`
+	})
+	if err := json.Unmarshal(b, reply); err != nil || len(reply.Value) <= screenshotLimit {
+		return b
+	}
+
+	reply.Value = fmt.Sprintf("%s...(%d bytes)", reply.Value[:screenshotLimit], len(reply.Value))
+
+	t, err := json.Marshal(reply)
+	if err != nil {
+		return b
+	}
+
+	return t
+}
+
+// truncateFile
+// shortens base64 zip value in upload file request
+func truncateFile(b []byte) []byte {
+	req := new(struct {
+		File string `json:"file"`
+	})
+	if err := json.Unmarshal(b, req); err != nil || len(req.File) <= screenshotLimit {
+		return b
+	}
+
+	req.File = fmt.Sprintf("%s...(%d bytes)", req.File[:screenshotLimit], len(req.File))
+
+	t, err := json.Marshal(req)
+	if err != nil {
+		return b
+	}
+
+	return t
+}
+
+// Trace
+// writes JSON lines trace of each WebDriver command to w
+// nil writer stops tracing
+func (c *WebClient) Trace(w io.Writer) {
+	c.tracer.mu.Lock()
+	defer c.tracer.mu.Unlock()
+
+	c.tracer.w = w
+	c.Verbose = w != nil
+}
+
+// TraceFile
+// creates or appends to trace file
+// and starts tracing
+func (c *WebClient) TraceFile(name string) error {
+	f, err := os.OpenFile(name, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("error on open trace file: %w", err)
+	}
+
+	c.CloseTrace()
+	c.Trace(f)
+
+	c.tracer.mu.Lock()
+	c.tracer.file = f
+	c.tracer.mu.Unlock()
+
+	return nil
+}
+
+// CloseTrace
+// stops tracing and closes trace file
+func (c *WebClient) CloseTrace() error {
+	c.tracer.mu.Lock()
+	f := c.tracer.file
+	c.tracer.file = nil
+	c.tracer.w = nil
+	c.tracer.mu.Unlock()
+
+	c.Verbose = false
+
+	if f == nil {
+		return nil
+	}
+
+	return f.Close()
+}
+
+// ReadTrace
+// reads JSON lines trace
+// to inspect commands of failed run
+func ReadTrace(r io.Reader) ([]*TraceRecord, error) {
+	var recs []*TraceRecord
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		rec := new(TraceRecord)
+		if err := json.Unmarshal(scanner.Bytes(), rec); err != nil {
+			return nil, fmt.Errorf("error on unmarshal trace record: %w", err)
+		}
+
+		recs = append(recs, rec)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error on read trace: %w", err)
+	}
+
+	return recs, nil
+}
@@ -0,0 +1,85 @@
+package client_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mcsymiv/gost/client"
+	"github.com/mcsymiv/gost/config"
+)
+
+func TestEndpoint(t *testing.T) {
+	cases := map[string]string{
+		"/session":                                "/session",
+		"/session/abc/url":                        "/session/{id}/url",
+		"/session/abc/element/active":             "/session/{id}/element/active",
+		"/session/abc/element/def/click":          "/session/{id}/element/{id}/click",
+		"/session/abc/element/def/attribute/href": "/session/{id}/element/{id}/attribute/href",
+		"/session/abc/shadow/ghi/element":         "/session/{id}/shadow/{id}/element",
+	}
+
+	for path, want := range cases {
+		if got := client.Endpoint(path); got != want {
+			t.Errorf("Endpoint(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestTrace(t *testing.T) {
+	screenshot := strings.Repeat("A", 1000)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(config.ContenType, config.ApplicationJson)
+		if strings.HasSuffix(r.URL.Path, "/screenshot") {
+			json.NewEncoder(w).Encode(map[string]string{"value": screenshot})
+			return
+		}
+
+		w.Write([]byte(`{"value":null}`))
+	}))
+	defer srv.Close()
+
+	config.Config = config.DefaultConfig()
+	config.Config.WebServerAddr = srv.URL
+
+	var buf bytes.Buffer
+	cl := client.NewClient()
+	cl.Trace(&buf)
+
+	if !cl.Verbose {
+		t.Error("expected Verbose client on trace")
+	}
+
+	if err := cl.Click("s1", "e1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// screenshot decode fails on fake value
+	cl.Screenshot("s1")
+
+	recs, err := client.ReadTrace(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(recs) != 2 {
+		t.Fatalf("expected 2 trace records, got: %d", len(recs))
+	}
+
+	click := recs[0]
+	if click.Method != http.MethodPost || click.Endpoint != "/session/{id}/element/{id}/click" || click.Status != http.StatusOK {
+		t.Errorf("unexpected click record: %+v", click)
+	}
+
+	if string(click.Request) != "{}" || string(click.Response) != `{"value":null}` {
+		t.Errorf("unexpected click bodies: %s, %s", click.Request, click.Response)
+	}
+
+	if len(recs[1].Response) > 200 || !strings.Contains(string(recs[1].Response), "(1000 bytes)") {
+		t.Errorf("expected truncated screenshot, got: %s", recs[1].Response)
+	}
+}
@@ -1,11 +1,35 @@
 package client
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log"
+	"math"
+	"math/rand"
 	"net/http"
+	"strings"
+	"syscall"
 	"time"
 )
 
+// attemptKey
+// request context key
+// holds retry attempt number, starting from 1
+type attemptKey struct{}
+
+func attempt(ctx context.Context) int {
+	n, ok := ctx.Value(attemptKey{}).(int)
+	if !ok {
+		return 1
+	}
+
+	return n
+}
+
 // retryRoundTripper
 // http.RoundTrip client middleware
 // most of request retries will be handled in strategies
@@ -14,17 +38,138 @@ type retry struct {
 	next       http.RoundTripper
 	maxRetries int
 	delay      time.Duration
+	maxDelay   time.Duration
+	jitter     float64
 }
 
 // RoundTrip
 // middleware for retries
+// resends request on connection refused,
+// and on EOF, 5xx response for idempotent commands
 func (rr retry) RoundTrip(r *http.Request) (*http.Response, error) {
-	res, err := rr.next.RoundTrip(r)
+	for n := 1; ; n++ {
+		req := r.WithContext(context.WithValue(r.Context(), attemptKey{}, n))
+
+		// rewind body on retry
+		if n > 1 && r.Body != nil && r.Body != http.NoBody {
+			body, err := r.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("error on rewind request body: %w", err)
+			}
+			req.Body = body
+		}
+
+		res, err := rr.next.RoundTrip(req)
+		if n > rr.maxRetries || !retryable(r, res, err) {
+			return res, err
+		}
+
+		if r.Body != nil && r.Body != http.NoBody && r.GetBody == nil {
+			return res, err
+		}
+
+		CloseResponse(res)
+
+		select {
+		case <-r.Context().Done():
+			return nil, r.Context().Err()
+		case <-time.After(rr.backoff(n)):
+		}
+	}
+}
+
+// backoff
+// exponential delay for n attempt
+// capped by maxDelay with random jitter
+// zero delay does not wait
+func (rr retry) backoff(n int) time.Duration {
+	if rr.delay <= 0 {
+		return 0
+	}
+
+	d := rr.delay << (n - 1)
+
+	// shift overflow
+	if n > 63 || d>>(n-1) != rr.delay {
+		d = time.Duration(math.MaxInt64)
+	}
+
+	if rr.maxDelay > 0 && d > rr.maxDelay {
+		d = rr.maxDelay
+	}
+
+	if rr.jitter > 0 {
+		d += time.Duration(float64(d) * rr.jitter * (2*rand.Float64() - 1))
+	}
+
+	return d
+}
+
+// retryable
+// connection refused means request never reached the driver
+// and is safe to resend for any command
+// EOF and 5xx responses are resent only for idempotent commands
+// 5xx on find element(s) is not resent,
+// service already polls find for WaitForTimeout
+func retryable(r *http.Request, res *http.Response, err error) bool {
 	if err != nil {
-		return res, err
+		if errors.Is(err, syscall.ECONNREFUSED) {
+			return true
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return idempotent(r)
+		}
+
+		return false
 	}
 
-	return res, nil
+	return res.StatusCode >= http.StatusInternalServerError && idempotent(r) && !find(r) && !alertOpen(res)
+}
+
+// alertOpen
+// unexpected alert open response is not resent
+// alert blocks commands until it is handled
+// response body is restored for decoding
+func alertOpen(res *http.Response) bool {
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	reply := new(struct {
+		Value struct {
+			Error string `json:"error"`
+		}
+	})
+	if err := json.Unmarshal(body, reply); err != nil {
+		return false
+	}
+
+	return reply.Value.Error == ErrUnexpectedAlertOpen.Code
+}
+
+// idempotent
+// GET, HEAD and find element(s) commands
+// do not change browser state
+func idempotent(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	case http.MethodPost:
+		return find(r)
+	}
+
+	return false
+}
+
+// find
+// POST find element(s) command
+func find(r *http.Request) bool {
+	return r.Method == http.MethodPost &&
+		(strings.HasSuffix(r.URL.Path, "/element") || strings.HasSuffix(r.URL.Path, "/elements"))
 }
 
 // loggingRoundTripper
@@ -34,11 +179,17 @@ type loggin struct {
 
 // RountTrip
 // middleware logger for Client
+// logs each attempt with its result
 func (l loggin) RoundTrip(r *http.Request) (*http.Response, error) {
+	n := attempt(r.Context())
+
 	res, err := l.next.RoundTrip(r)
 	if err != nil {
-		return nil, fmt.Errorf("error on %v request: %v", r, err)
+		log.Printf("attempt %d: %s %s: %v", n, r.Method, r.URL.Path, err)
+		return nil, fmt.Errorf("error on %s %s request: %w", r.Method, r.URL.Path, err)
 	}
 
+	log.Printf("attempt %d: %s %s: %s", n, r.Method, r.URL.Path, res.Status)
+
 	return res, nil
 }
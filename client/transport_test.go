@@ -0,0 +1,141 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// scripted
+// RoundTripper that replies with prepared results
+// and records request bodies
+type scripted struct {
+	replies []func() (*http.Response, error)
+	bodies  []string
+}
+
+func (s *scripted) RoundTrip(r *http.Request) (*http.Response, error) {
+	var b []byte
+	if r.Body != nil {
+		b, _ = io.ReadAll(r.Body)
+	}
+	s.bodies = append(s.bodies, string(b))
+
+	reply := s.replies[len(s.bodies)-1]
+	return reply()
+}
+
+func status(code int) func() (*http.Response, error) {
+	return func() (*http.Response, error) {
+		return &http.Response{
+			StatusCode: code,
+			Status:     fmt.Sprintf("%d", code),
+			Body:       io.NopCloser(strings.NewReader("{}")),
+		}, nil
+	}
+}
+
+func refused() (*http.Response, error) {
+	return nil, fmt.Errorf("dial: %w", syscall.ECONNREFUSED)
+}
+
+func eof() (*http.Response, error) {
+	return nil, fmt.Errorf("read: %w", io.EOF)
+}
+
+func roundTrip(t *testing.T, next *scripted, method, path string) (*http.Response, error) {
+	rt := &retry{
+		maxRetries: 2,
+		next:       &loggin{next: next},
+	}
+
+	req, err := http.NewRequest(method, "http://localhost:8080"+path, bytes.NewBufferString(`{"using":"css selector"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return rt.RoundTrip(req)
+}
+
+func TestRetryRewindsBody(t *testing.T) {
+	next := &scripted{replies: []func() (*http.Response, error){refused, eof, status(200)}}
+
+	res, err := roundTrip(t, next, http.MethodPost, "/session/1/element")
+	if err != nil || res.StatusCode != http.StatusOK {
+		t.Fatalf("expected ok response, got: %v, %v", res, err)
+	}
+
+	if len(next.bodies) != 3 {
+		t.Fatalf("expected 3 attempts, got: %d", len(next.bodies))
+	}
+
+	for _, b := range next.bodies {
+		if b != `{"using":"css selector"}` {
+			t.Errorf("expected rewound body, got: %q", b)
+		}
+	}
+}
+
+func TestRetryMaxRetries(t *testing.T) {
+	next := &scripted{replies: []func() (*http.Response, error){status(500), status(500), status(500), status(200)}}
+
+	res, _ := roundTrip(t, next, http.MethodGet, "/session/1/element/2/text")
+	if res.StatusCode != http.StatusInternalServerError || len(next.bodies) != 3 {
+		t.Fatalf("expected 3 attempts with last 500, got: %d attempts, %d", len(next.bodies), res.StatusCode)
+	}
+}
+
+func TestRetryNonIdempotent(t *testing.T) {
+	next := &scripted{replies: []func() (*http.Response, error){status(500), status(200)}}
+
+	res, _ := roundTrip(t, next, http.MethodPost, "/session/1/element/2/click")
+	if res.StatusCode != http.StatusInternalServerError || len(next.bodies) != 1 {
+		t.Fatalf("expected click not to be resent, got: %d attempts", len(next.bodies))
+	}
+
+	next = &scripted{replies: []func() (*http.Response, error){eof, status(200)}}
+
+	_, err := roundTrip(t, next, http.MethodPost, "/session/1/element/2/value")
+	if err == nil || len(next.bodies) != 1 {
+		t.Fatalf("expected value not to be resent after EOF, got: %d attempts", len(next.bodies))
+	}
+
+	next = &scripted{replies: []func() (*http.Response, error){refused, status(200)}}
+
+	res, err = roundTrip(t, next, http.MethodPost, "/session/1/element/2/click")
+	if err != nil || res.StatusCode != http.StatusOK || len(next.bodies) != 2 {
+		t.Fatalf("expected refused click to be resent, got: %d attempts, %v", len(next.bodies), err)
+	}
+}
+
+func TestRetryFind(t *testing.T) {
+	next := &scripted{replies: []func() (*http.Response, error){status(500), status(200)}}
+
+	res, _ := roundTrip(t, next, http.MethodPost, "/session/1/elements")
+	if res.StatusCode != http.StatusInternalServerError || len(next.bodies) != 1 {
+		t.Fatalf("expected find not to be resent on 5xx, got: %d attempts", len(next.bodies))
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	rr := retry{maxDelay: 2 * time.Second}
+	if d := rr.backoff(1); d != 0 {
+		t.Errorf("expected no delay, got: %v", d)
+	}
+
+	rr.delay = 100 * time.Millisecond
+	if d := rr.backoff(3); d != 400*time.Millisecond {
+		t.Errorf("expected 400ms delay, got: %v", d)
+	}
+
+	for _, n := range []int{6, 40, 64, 100} {
+		if d := rr.backoff(n); d != rr.maxDelay {
+			t.Errorf("expected max delay on attempt %d, got: %v", n, d)
+		}
+	}
+}
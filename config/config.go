@@ -37,6 +37,12 @@ var (
 const ApplicationJson string = "application/json"
 const ContenType string = "Content-Type"
 
+// WaitTimeoutHeader, WaitIntervalHeader
+// per-request override of WaitForTimeout, WaitForInterval
+// for service retry loops, i.e. "1.5s", "100ms"
+const WaitTimeoutHeader string = "X-Gost-Wait-Timeout"
+const WaitIntervalHeader string = "X-Gost-Wait-Interval"
+
 type WebConfig struct {
 	// WebServerAddr
 	// Default value http://localhost:8080
@@ -51,6 +57,12 @@ type WebConfig struct {
 	// DriverLogsFile
 	DriverLogsFile string
 
+	// TraceFile
+	// JSON lines file where client records
+	// each WebDriver command request and response
+	// tracing is disabled if empty
+	TraceFile string
+
 	// ConfigFile
 	// name of cinfiguration file
 	ConfigFile string
@@ -72,9 +84,31 @@ type WebConfig struct {
 	// 200 ms is an arbitrary value
 	WaitForInterval time.Duration
 
+	// RetryMax
+	// number of client retries on connection refused,
+	// EOF and 5xx driver responses
+	// 3 retries default value
+	RetryMax int
+
+	// RetryDelay
+	// delay in ms before first client retry
+	// doubled on each next retry, 0 retries without delay
+	// 100 ms default value
+	RetryDelay time.Duration
+
+	// RetryMaxDelay
+	// upper bound in ms of client retry delay
+	// 2000 ms default value
+	RetryMaxDelay time.Duration
+
+	// RetryJitter
+	// random fraction added or subtracted from retry delay
+	// i.e. 0.2 spreads 100 ms delay to 80-120 ms
+	RetryJitter float64
+
 	// RefreshOnFindError
-	// calls /session/{sessionId}/refresh
-	// if find retry fails
+	// calls /session/{sessionId}/refresh once
+	// if find retry times out, then finds once more
 	RefreshOnFindError bool
 
 	// Artifact path
@@ -108,6 +142,10 @@ func DefaultConfig() *WebConfig {
 		ScreenshotOnFail: true,
 		WaitForTimeout:   20,
 		WaitForInterval:  200,
+		RetryMax:         3,
+		RetryDelay:       100,
+		RetryMaxDelay:    2000,
+		RetryJitter:      0.2,
 		JsFilesPath:      GetPath("js"),
 		ScreenshotsPath:  GetPath("screenshots"),
 		RecordsPath:      GetPath("records"),
@@ -130,12 +168,19 @@ func NewConfig(confFn ...ConfigFunc) *WebConfig {
 		WebDriverPort:    os.Getenv("DRIVER_PORT"),
 		WebDriverAddr:    fmt.Sprintf("%s:%s", os.Getenv("DRIVER_HOST"), os.Getenv("DRIVER_PORT")),
 		DriverLogsFile:   GetRoot(os.Getenv("DRIVER_LOGS")),
+		TraceFile:        os.Getenv("TRACE_FILE"),
 		WaitForTimeout:   toWaitTimeout(os.Getenv("WAIT_TIMEOUT")),
 		WaitForInterval:  toWaitInterval(os.Getenv("WAIT_INTERVAL")),
+		RetryMax:         toRetryMax(os.Getenv("RETRY_MAX")),
+		RetryDelay:       toDuration(os.Getenv("RETRY_DELAY"), 100),
+		RetryMaxDelay:    toDuration(os.Getenv("RETRY_MAX_DELAY"), 2000),
+		RetryJitter:      toRetryJitter(os.Getenv("RETRY_JITTER")),
 		JsFilesPath:      GetPath(os.Getenv("JS_FILES_PATH")),
 		ScreenshotsPath:  GetPath(os.Getenv("SCREENSHOTS_PATH")),
 		RecordsPath:      GetPath(os.Getenv("RECORDS_PATH")),
 		ScreenshotOnFail: WebConfigDriverScreenshoOnFail(os.Getenv("SCREENSHOT_ON_FAIL")),
+
+		RefreshOnFindError: toRefreshOnFindError(os.Getenv("REFRESH_ON_FIND_ERROR")),
 	}
 
 	return conf
@@ -179,3 +224,41 @@ func toWaitInterval(dur string) time.Duration {
 
 	return time.Duration(d)
 }
+
+func toRefreshOnFindError(refresh string) bool {
+	r, err := strconv.ParseBool(refresh)
+	if err != nil {
+		return false
+	}
+
+	return r
+}
+
+func toRetryMax(n string) int {
+	r, err := strconv.Atoi(n)
+	if err != nil {
+		return 3
+	}
+
+	return r
+}
+
+func toRetryJitter(j string) float64 {
+	f, err := strconv.ParseFloat(j, 64)
+	if err != nil {
+		return 0.2
+	}
+
+	return f
+}
+
+// toDuration
+// parses env value, returns def on error
+func toDuration(dur string, def time.Duration) time.Duration {
+	d, err := strconv.Atoi(dur)
+	if err != nil {
+		return def
+	}
+
+	return time.Duration(d)
+}
@@ -9,3 +9,13 @@ const (
 	ByTagName         = "tag name"
 	ByCssSelector     = "css selector"
 )
+
+// ByPierce
+// gost strategy, not sent to driver
+// "host >>> inner" selector is resolved
+// through nested shadow roots by driver package
+const ByPierce = "pierce"
+
+// PierceSeparator
+// separates shadow host and inner selectors
+const PierceSeparator = ">>>"
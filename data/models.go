@@ -7,6 +7,11 @@ type DriverStatus struct {
 
 type Session struct {
 	Id string `json:"sessionId"`
+
+	// Capabilities
+	// returned by driver on new session
+	// i.e. browserName, browserVersion, platformName
+	Capabilities map[string]interface{} `json:"capabilities,omitempty"`
 }
 
 type Url struct {
@@ -32,7 +37,66 @@ type SendKeys struct {
 	Text string `json:"text"`
 }
 
+// UploadFile
+// base64 encoded zip archive
+// sent to driver /se/file endpoint
+type UploadFile struct {
+	File string `json:"file"`
+}
+
 type KeyAction struct {
 	Type string `json:"type"`
 	Key  string `json:"value"`
 }
+
+// ActionSource
+// W3C input source, i.e. key, pointer or wheel
+// with one action per tick
+type ActionSource struct {
+	Type       string                   `json:"type"`
+	Id         string                   `json:"id"`
+	Parameters map[string]string        `json:"parameters,omitempty"`
+	Actions    []map[string]interface{} `json:"actions"`
+}
+
+// Cookie
+// W3C cookie serialization
+// Expiry is unix time in seconds, session cookie if 0
+type Cookie struct {
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Path     string `json:"path,omitempty"`
+	Domain   string `json:"domain,omitempty"`
+	Secure   bool   `json:"secure,omitempty"`
+	HttpOnly bool   `json:"httpOnly,omitempty"`
+	Expiry   int64  `json:"expiry,omitempty"`
+	SameSite string `json:"sameSite,omitempty"`
+}
+
+// Rect
+// W3C window or element rect
+// in CSS pixels
+type Rect struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+// WindowRect
+// W3C set window rect request
+// nil fields are not sent and keep current value
+type WindowRect struct {
+	X      *float64 `json:"x,omitempty"`
+	Y      *float64 `json:"y,omitempty"`
+	Width  *float64 `json:"width,omitempty"`
+	Height *float64 `json:"height,omitempty"`
+}
+
+// NewWindow
+// W3C new window response
+// Type is "tab" or "window"
+type NewWindow struct {
+	Handle string `json:"handle"`
+	Type   string `json:"type"`
+}
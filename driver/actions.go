@@ -1,7 +1,12 @@
 package driver
 
 import (
+	"context"
 	"fmt"
+	"slices"
+	"time"
+
+	"github.com/mcsymiv/gost/data"
 )
 
 type InputSource string
@@ -35,71 +40,447 @@ const (
 )
 
 // KeyAction represents an activity involving a keyboard key.
+//
+// Deprecated: use Actions builder KeyDown, KeyUp
 type KeyAction map[string]interface{}
 
 // PointerAction represents an activity involving a pointer.
+//
+// Deprecated: use Actions builder pointer methods
 type PointerAction map[string]interface{}
 
-// Actions stores KeyActions and PointerActions for later execution.
-type Actions []map[string]interface{}
+type ActionType string
 
-// KeyActions
-// Resigters KeyActions
-func (w *WebDriver) KeyActions(inputId string, actions ...KeyAction) {
-	rawActions := []map[string]interface{}{}
-	keyActions := []map[string]interface{}{}
+const (
+	KeyUpAction   ActionType = "keyUp"
+	KeyDownAction ActionType = "keyDown"
 
-	for _, action := range actions {
-		rawActions = append(rawActions, action)
+	PointerDown   ActionType = "pointerDown"
+	PointerUp     ActionType = "pointerUp"
+	PointerMove   ActionType = "pointerMove"
+	PointerCancel ActionType = "pointerCancel"
+
+	PauseAction  ActionType = "pause"
+	ScrollAction ActionType = "scroll"
+)
+
+// MouseButton
+// W3C pointer button
+type MouseButton int
+
+const (
+	LeftButton   MouseButton = 0
+	MiddleButton MouseButton = 1
+	RightButton  MouseButton = 2
+)
+
+// default input sources ids
+const (
+	keyboardSource = "keyboard"
+	mouseSource    = "mouse"
+	wheelSource    = "wheel"
+	nullSource     = "null"
+)
+
+// source
+// input source with actions by tick
+// nil action is a pause
+type source struct {
+	typ         InputSource
+	id          string
+	pointerType PointerType
+	actions     []map[string]interface{}
+}
+
+// Actions
+// chainable W3C actions builder
+// each action takes next tick, other sources pause,
+// actions added in Tick are dispatched together
+//
+//	d.Actions().
+//		KeyDown(ShiftKey).
+//		MoveTo(d.F("#item"), 0, 0).
+//		PointerDown(LeftButton).
+//		MoveBy(0, 100).
+//		PointerUp(LeftButton).
+//		KeyUp(ShiftKey).
+//		Perform()
+type Actions struct {
+	wd *WebDriver
+
+	sources []*source
+	pointer string
+
+	// duration
+	// of next pointer moves and scrolls
+	duration time.Duration
+
+	// pauses
+	// duration of Pause ticks
+	pauses map[int]time.Duration
+
+	ticks int
+	tick  bool
+	err   error
+}
+
+// Actions
+// starts new actions builder
+// with "mouse" as current pointer
+func (w *WebDriver) Actions() *Actions {
+	return &Actions{
+		wd:      w,
+		pointer: mouseSource,
+		pauses:  map[int]time.Duration{},
+	}
+}
+
+// source
+// returns input source by id, adds it if missing
+func (a *Actions) source(typ InputSource, id string, pointerType PointerType) *source {
+	for _, s := range a.sources {
+		if s.id == id {
+			return s
+		}
+	}
+
+	s := &source{
+		typ:         typ,
+		id:          id,
+		pointerType: pointerType,
+	}
+	a.sources = append(a.sources, s)
+
+	return s
+}
+
+// add
+// sets action to the next tick of source,
+// or to the current tick inside Tick
+func (a *Actions) add(s *source, action map[string]interface{}) *Actions {
+	if !a.tick {
+		a.ticks++
+	}
+
+	for len(s.actions) < a.ticks {
+		s.actions = append(s.actions, nil)
 	}
 
-	keyActions = append(keyActions, map[string]interface{}{
-		"type":    KeyInput,
-		"id":      inputId,
-		"actions": rawActions,
+	if s.actions[a.ticks-1] != nil && a.err == nil {
+		a.err = fmt.Errorf("source %q has more than one action in tick %d", s.id, a.ticks)
+	}
+
+	s.actions[a.ticks-1] = action
+
+	return a
+}
+
+func (a *Actions) key(t ActionType, key string) *Actions {
+	return a.add(a.source(KeyInput, keyboardSource, ""), map[string]interface{}{
+		"type":  t,
+		"value": key,
 	})
+}
 
-	// err := w.WebClient.Action(key, string(action), w.SessionId)
-	// if err != nil {
-	// 	panic(fmt.Sprintf("error on action: %v", err))
-	// }
-	//
-	// err = w.WebClient.ReleaseAction(w.SessionId)
-	// if err != nil {
-	// 	panic(fmt.Sprintf("error on release action: %v", err))
-	// }
+func (a *Actions) pointerAction(action map[string]interface{}) *Actions {
+	pt := MousePointer
+	for _, s := range a.sources {
+		if s.id == a.pointer {
+			pt = s.pointerType
+		}
+	}
+
+	return a.add(a.source(PointerInput, a.pointer, pt), action)
 }
 
-// PointerActions
-// Registers Pointer Actions
-func (wd *WebDriver) PointerActions(inputId string, actions ...PointerAction) {
-	rawActions := []map[string]interface{}{}
-	pointerActions := []map[string]interface{}{}
+func (a *Actions) move(origin interface{}, x, y int) *Actions {
+	return a.pointerAction(map[string]interface{}{
+		"type":     PointerMove,
+		"origin":   origin,
+		"x":        x,
+		"y":        y,
+		"duration": a.duration.Milliseconds(),
+	})
+}
 
-	for _, action := range actions {
-		rawActions = append(rawActions, action)
+// Tick
+// dispatches actions added in fn together, in single tick
+// i.e. multiple touch pointers
+// each source can have one action per tick
+func (a *Actions) Tick(fn func(a *Actions)) *Actions {
+	a.ticks++
+	a.tick = true
+	fn(a)
+	a.tick = false
+
+	return a
+}
+
+// Pause
+// waits for duration in separate tick
+// can't be used inside Tick
+func (a *Actions) Pause(d time.Duration) *Actions {
+	if a.tick {
+		if a.err == nil {
+			a.err = fmt.Errorf("pause inside tick %d", a.ticks)
+		}
+
+		return a
+	}
+
+	a.ticks++
+	a.pauses[a.ticks-1] = d
+
+	return a
+}
+
+// Duration
+// sets duration of next pointer moves and scrolls
+// 0 by default
+func (a *Actions) Duration(d time.Duration) *Actions {
+	a.duration = d
+	return a
+}
+
+// KeyDown
+// presses key, i.e. ShiftKey or "a"
+func (a *Actions) KeyDown(key string) *Actions {
+	return a.key(KeyDownAction, key)
+}
+
+// KeyUp
+// releases key
+func (a *Actions) KeyUp(key string) *Actions {
+	return a.key(KeyUpAction, key)
+}
+
+// SendKeys
+// presses and releases each key of text
+// modifier keys stay pressed until next press of the same modifier,
+// or NullKey, as in element send keys
+func (a *Actions) SendKeys(text string) *Actions {
+	var held []string
+
+	release := func(key string) {
+		for i, h := range held {
+			if h == key {
+				held = append(held[:i], held[i+1:]...)
+				a.KeyUp(key)
+				return
+			}
+		}
+	}
+
+	for _, r := range text {
+		key := string(r)
+
+		switch {
+		case key == NullKey:
+			for len(held) > 0 {
+				release(held[len(held)-1])
+			}
+		case modifiers[key]:
+			if slices.Contains(held, key) {
+				release(key)
+				continue
+			}
+
+			held = append(held, key)
+			a.KeyDown(key)
+		default:
+			a.KeyDown(key).KeyUp(key)
+		}
+	}
+
+	for len(held) > 0 {
+		release(held[len(held)-1])
 	}
 
-	pointerActions = append(pointerActions, map[string]interface{}{
-		"type":       PointerInput,
-		"id":         inputId,
-		"parameters": map[string]string{"pointerType": string(PointerInput)},
-		"actions":    rawActions,
+	return a
+}
+
+// Pointer
+// sets current pointer source for next pointer actions
+// source is added on first use, i.e. second touch finger
+// pointer type not supported by session browser
+// fails Perform with ErrUnsupportedPointer
+func (a *Actions) Pointer(id string, pointerType PointerType) *Actions {
+	if err := a.wd.supports(pointerType); err != nil && a.err == nil {
+		a.err = err
+	}
+
+	a.source(PointerInput, id, pointerType)
+	a.pointer = id
+
+	return a
+}
+
+// MoveTo
+// moves pointer to offset from element center
+func (a *Actions) MoveTo(el *WebElement, x, y int) *Actions {
+	return a.move(el, x, y)
+}
+
+// MoveToPoint
+// moves pointer to viewport coordinates
+func (a *Actions) MoveToPoint(x, y int) *Actions {
+	return a.move(FromViewport, x, y)
+}
+
+// MoveBy
+// moves pointer by offset from its current position
+func (a *Actions) MoveBy(x, y int) *Actions {
+	return a.move(FromPointer, x, y)
+}
+
+// PointerDown
+// presses pointer button
+func (a *Actions) PointerDown(button MouseButton) *Actions {
+	return a.pointerAction(map[string]interface{}{
+		"type":   PointerDown,
+		"button": button,
 	})
 }
 
-type ActionType string
+// PointerUp
+// releases pointer button
+func (a *Actions) PointerUp(button MouseButton) *Actions {
+	return a.pointerAction(map[string]interface{}{
+		"type":   PointerUp,
+		"button": button,
+	})
+}
 
-const (
-	KeyUpAction   ActionType = "keyUp"
-	KeyDownAction ActionType = "keyDown"
+// ScrollBy
+// scrolls viewport by delta
+// with wheel at viewport top left corner
+func (a *Actions) ScrollBy(deltaX, deltaY int) *Actions {
+	return a.scroll(FromViewport, 0, 0, deltaX, deltaY)
+}
 
-	PointerDown   ActionType = "pointerDown"
-	PointerUp     ActionType = "pointerUp"
-	PointerMove   ActionType = "pointerMove"
-	PointerCancel ActionType = "pointerCancel"
-)
+// ScrollFrom
+// scrolls by delta with wheel
+// at offset from element center
+// element is scrolled into view first
+func (a *Actions) ScrollFrom(el *WebElement, x, y, deltaX, deltaY int) *Actions {
+	return a.scroll(el, x, y, deltaX, deltaY)
+}
 
+func (a *Actions) scroll(origin interface{}, x, y, deltaX, deltaY int) *Actions {
+	return a.add(a.source(WheelInput, wheelSource, ""), map[string]interface{}{
+		"type":     ScrollAction,
+		"origin":   origin,
+		"x":        x,
+		"y":        y,
+		"deltaX":   deltaX,
+		"deltaY":   deltaY,
+		"duration": a.duration.Milliseconds(),
+	})
+}
+
+// Sources
+// W3C input sources with actions
+// padded with pauses to the same number of ticks
+// pause only actions are sent with null input source
+func (a *Actions) Sources() []data.ActionSource {
+	srcs := a.sources
+	if len(srcs) == 0 && a.ticks > 0 {
+		srcs = []*source{{typ: NullInput, id: nullSource}}
+	}
+
+	sources := make([]data.ActionSource, 0, len(srcs))
+
+	for _, s := range srcs {
+		src := data.ActionSource{
+			Type:    string(s.typ),
+			Id:      s.id,
+			Actions: make([]map[string]interface{}, 0, a.ticks),
+		}
+
+		if s.typ == PointerInput {
+			src.Parameters = map[string]string{"pointerType": string(s.pointerType)}
+		}
+
+		for i := 0; i < a.ticks; i++ {
+			if i < len(s.actions) && s.actions[i] != nil {
+				src.Actions = append(src.Actions, s.actions[i])
+				continue
+			}
+
+			pause := map[string]interface{}{"type": PauseAction}
+			if d, ok := a.pauses[i]; ok {
+				pause["duration"] = d.Milliseconds()
+			}
+
+			src.Actions = append(src.Actions, pause)
+		}
+
+		sources = append(sources, src)
+	}
+
+	return sources
+}
+
+// PerformE
+// sends actions to /actions
+// and releases pressed keys and buttons
+// keys and buttons pressed before failed action are released too
+func (a *Actions) PerformE() error {
+	if a.err != nil {
+		return driverError("actions", nil, a.err)
+	}
+
+	if a.ticks == 0 {
+		return nil
+	}
+
+	err := a.wd.WebClient.PerformActionsContext(a.wd.Context(), a.Sources(), a.wd.SessionId)
+	if err != nil {
+		// release even if driver context is cancelled
+		a.wd.WithContext(context.WithoutCancel(a.wd.Context())).ReleaseActionE()
+		return driverError("actions", nil, err)
+	}
+
+	return a.wd.ReleaseActionE()
+}
+
+func (a *Actions) Perform() {
+	must(a.PerformE())
+}
+
+// KeyActions
+// performs key actions of inputId source
+//
+// Deprecated: use Actions builder
+//
+//	d.Actions().KeyDown(driver.ShiftKey).SendKeys("a").KeyUp(driver.ShiftKey).Perform()
+func (w *WebDriver) KeyActions(inputId string, actions ...KeyAction) {
+	a := w.Actions()
+	for _, action := range actions {
+		a.add(a.source(KeyInput, inputId, ""), action)
+	}
+
+	a.Perform()
+}
+
+// PointerActions
+// performs mouse pointer actions of inputId source
+//
+// Deprecated: use Actions builder
+//
+//	d.Actions().MoveTo(el, 0, 0).PointerDown(driver.LeftButton).PointerUp(driver.LeftButton).Perform()
+func (w *WebDriver) PointerActions(inputId string, actions ...PointerAction) {
+	a := w.Actions()
+	for _, action := range actions {
+		a.add(a.source(PointerInput, inputId, MousePointer), action)
+	}
+
+	a.Perform()
+}
+
+// KeyDown
+//
+// Deprecated: use Actions builder KeyDown
 func KeyDown(key string) KeyAction {
 	return KeyAction{
 		"type":  KeyDownAction,
@@ -107,6 +488,9 @@ func KeyDown(key string) KeyAction {
 	}
 }
 
+// KeyUp
+//
+// Deprecated: use Actions builder KeyUp
 func KeyUp(key string) KeyAction {
 	return KeyAction{
 		"type":  KeyUpAction,
@@ -114,40 +498,57 @@ func KeyUp(key string) KeyAction {
 	}
 }
 
-func (w *WebDriver) Action(key string, action ActionType) {
-	err := w.WebClient.Action(key, string(action), w.SessionId)
+func (w *WebDriver) ActionE(key string, action ActionType) error {
+	err := w.WebClient.ActionContext(w.Context(), key, string(action), w.SessionId)
 	if err != nil {
-		panic(fmt.Sprintf("error on action: %v", err))
+		return driverError("action", nil, err)
 	}
 
-	err = w.WebClient.ReleaseAction(w.SessionId)
+	err = w.WebClient.ReleaseActionContext(w.Context(), w.SessionId)
 	if err != nil {
-		panic(fmt.Sprintf("error on release action: %v", err))
+		return driverError("release action", nil, err)
 	}
+
+	return nil
 }
 
-// ReleaseAction
+func (w *WebDriver) Action(key string, action ActionType) {
+	must(w.ActionE(key, action))
+}
+
+// ReleaseActionE
 // Causes events to be fired
 // as if the state was released by an explicit series of actions.
 // It also clears all the internal state of the virtual devices.
-func (w *WebDriver) ReleaseAction() {
-	err := w.WebClient.ReleaseAction(w.SessionId)
+func (w *WebDriver) ReleaseActionE() error {
+	err := w.WebClient.ReleaseActionContext(w.Context(), w.SessionId)
 	if err != nil {
-		panic(fmt.Sprintf("error on action: %v", err))
+		return driverError("release action", nil, err)
 	}
 
+	return nil
 }
 
-// Keys
-// sends a sequence of strings/text from driver
-func (w *WebDriver) Keys(key string) {
-	err := w.WebClient.Action(key, string(KeyDownAction), w.SessionId)
-	if err != nil {
-		panic(fmt.Sprintf("error on keys down action: %v", err))
-	}
+// ReleaseAction
+// Causes events to be fired
+// as if the state was released by an explicit series of actions.
+// It also clears all the internal state of the virtual devices.
+func (w *WebDriver) ReleaseAction() {
+	must(w.ReleaseActionE())
+}
 
-	err = w.WebClient.ReleaseAction(w.SessionId)
-	if err != nil {
-		panic(fmt.Sprintf("error on release action: %v", err))
-	}
+// KeysE
+// sends keys to focused element
+// with keyDown and keyUp of each key,
+// modifiers are held as in SendKeys
+//
+//	d.Keys(driver.Chord(driver.ShiftKey, driver.TabKey))
+func (w *WebDriver) KeysE(keys string) error {
+	return w.Actions().SendKeys(keys).PerformE()
+}
+
+// Keys
+// sends keys to focused element
+func (w *WebDriver) Keys(keys string) {
+	must(w.KeysE(keys))
 }
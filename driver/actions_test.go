@@ -0,0 +1,189 @@
+package driver_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mcsymiv/gost/driver"
+	"github.com/mcsymiv/gost/fake"
+)
+
+func TestActions(t *testing.T) {
+	d, srv := fake.Gost(t)
+
+	clicks := 0
+	srv.Page(home,
+		fake.El("input", fake.Attr("id", "q"), fake.Rect(0, 0, 200, 30)),
+		fake.El("button", fake.Attr("id", "save"), fake.Text("Save"), fake.Rect(0, 100, 80, 30),
+			fake.OnClick(func(s *fake.Session, n *fake.Node) { clicks++ })),
+	)
+
+	d.Open(home)
+	d.F("#q").Click()
+
+	save := d.F("#save")
+	a := d.Actions().
+		SendKeys("hi").
+		MoveTo(save, 0, 0).
+		PointerDown(driver.LeftButton).
+		PointerUp(driver.LeftButton).
+		Pause(50*time.Millisecond).
+		MoveToPoint(10, 110).
+		PointerDown(driver.LeftButton).
+		MoveBy(5, 0).
+		PointerUp(driver.LeftButton).
+		ScrollBy(0, 300)
+
+	sources := a.Sources()
+	if len(sources) != 3 {
+		t.Fatalf("expected keyboard, mouse and wheel sources, got: %+v", sources)
+	}
+
+	for _, src := range sources {
+		if len(src.Actions) != 13 {
+			t.Errorf("expected %s source padded to 13 ticks, got: %d", src.Id, len(src.Actions))
+		}
+	}
+
+	// pause tick of all sources
+	if p := sources[1].Actions[7]; p["type"] != driver.PauseAction || p["duration"] != int64(50) {
+		t.Errorf("unexpected pause tick: %v", p)
+	}
+
+	if pt := sources[1].Parameters["pointerType"]; pt != "mouse" {
+		t.Errorf("unexpected pointer type: %q", pt)
+	}
+
+	a.Perform()
+
+	s := srv.Session(d.SessionId)
+	if v := s.Document().Find("#q").Attrs["value"]; v != "hi" {
+		t.Errorf("unexpected typed value: %q", v)
+	}
+
+	// element origin and viewport point clicks
+	if clicks != 2 {
+		t.Errorf("expected 2 pointer clicks, got: %d", clicks)
+	}
+
+	if n := srv.Count("DELETE /session/{id}/actions"); n != 1 {
+		t.Errorf("expected actions release, got: %d", n)
+	}
+
+	b, _ := json.Marshal(sources[1].Actions[4]["origin"])
+	if string(b) != `{"element-6066-11e4-a52e-4f735466cecf":"`+save.WebElementId+`"}` {
+		t.Errorf("unexpected element origin: %s", b)
+	}
+}
+
+func TestActionsTick(t *testing.T) {
+	d, _ := fake.Gost(t)
+
+	a := d.Actions().
+		Tick(func(a *driver.Actions) {
+			a.Pointer("finger1", driver.TouchPointer).MoveToPoint(10, 10)
+			a.Pointer("finger2", driver.TouchPointer).MoveToPoint(20, 20)
+		}).
+		Tick(func(a *driver.Actions) {
+			a.Pointer("finger1", driver.TouchPointer).PointerDown(driver.LeftButton)
+			a.Pointer("finger2", driver.TouchPointer).PointerDown(driver.LeftButton)
+		})
+
+	sources := a.Sources()
+	if len(sources) != 2 || len(sources[0].Actions) != 2 || sources[1].Parameters["pointerType"] != "touch" {
+		t.Fatalf("unexpected touch sources: %+v", sources)
+	}
+
+	if err := a.PerformE(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err := d.Actions().Tick(func(a *driver.Actions) {
+		a.KeyDown("a").KeyDown("b")
+	}).PerformE()
+	if err == nil {
+		t.Error("expected error on two key actions in one tick")
+	}
+
+	err = d.Actions().Tick(func(a *driver.Actions) {
+		a.KeyDown("a").Pause(time.Second)
+	}).PerformE()
+	if err == nil {
+		t.Error("expected error on pause inside tick")
+	}
+}
+
+func TestActionsReleaseOnError(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home, fake.El("div", fake.Attr("id", "hidden"), fake.Hidden()))
+
+	d.Open(home)
+
+	err := d.Actions().
+		KeyDown(driver.ShiftKey).
+		MoveTo(d.F("#hidden"), 0, 0).
+		PerformE()
+	if err == nil {
+		t.Fatal("expected move target out of bounds")
+	}
+
+	if n := srv.Count("DELETE /session/{id}/actions"); n != 1 {
+		t.Errorf("expected actions release after failed perform, got: %d", n)
+	}
+}
+
+func TestActionsPause(t *testing.T) {
+	d, srv := fake.Gost(t)
+
+	d.Actions().Pause(50 * time.Millisecond).Perform()
+
+	s := srv.Session(d.SessionId)
+	if len(s.Actions) != 1 || len(s.Actions[0]) != 1 {
+		t.Fatalf("expected single pause source, got: %v", s.Actions)
+	}
+
+	b, _ := json.Marshal(s.Actions[0][0])
+	if string(b) != `{"actions":[{"duration":50,"type":"pause"}],"id":"null","type":"null"}` {
+		t.Errorf("unexpected pause source: %s", b)
+	}
+}
+
+func TestDeprecatedActions(t *testing.T) {
+	d, srv := fake.Gost(t)
+	doc := srv.Page(home, fake.El("input", fake.Attr("id", "q")))
+
+	d.Open(home)
+	d.F("#q").Click()
+
+	d.KeyActions("keys", driver.KeyDown("h"), driver.KeyUp("h"), driver.KeyDown("i"), driver.KeyUp("i"))
+	if v := doc.Find("#q").Attrs["value"]; v != "hi" {
+		t.Errorf("unexpected typed value: %q", v)
+	}
+
+	d.PointerActions("mouse", driver.PointerAction{"type": driver.PointerMove, "origin": "viewport", "x": 1, "y": 1})
+	if n := srv.Count("POST /session/{id}/actions"); n != 2 {
+		t.Errorf("expected 2 performed actions, got: %d", n)
+	}
+}
+
+func TestKeysError(t *testing.T) {
+	d, _ := fake.Gost(t)
+
+	err := d.KeysE(string(driver.ShiftKey) + "a")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = d.WithContext(ctx).KeysE("a")
+	var derr *driver.DriverError
+	if !errors.As(err, &derr) || derr.Op != "actions" || strings.Contains(err.Error(), "error on keys") {
+		t.Errorf("expected actions driver error, got: %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+package driver
+
+import (
+	"errors"
+
+	"github.com/mcsymiv/gost/client"
+)
+
+// AlertTextE
+// returns message of opened alert, confirm or prompt
+// client.ErrNoSuchAlert if none
+func (w *WebDriver) AlertTextE() (string, error) {
+	text, err := w.WebClient.AlertTextContext(w.Context(), w.SessionId)
+	if err != nil {
+		return "", driverError("alert text", nil, err)
+	}
+
+	return text, nil
+}
+
+func (w *WebDriver) AlertText() string {
+	text, err := w.AlertTextE()
+	must(err)
+
+	return text
+}
+
+// AcceptAlertE
+// clicks OK on opened alert, confirm or prompt
+func (w *WebDriver) AcceptAlertE() error {
+	err := w.WebClient.AcceptAlertContext(w.Context(), w.SessionId)
+	if err != nil {
+		return driverError("accept alert", nil, err)
+	}
+
+	return nil
+}
+
+func (w *WebDriver) AcceptAlert() {
+	must(w.AcceptAlertE())
+}
+
+// DismissAlertE
+// clicks Cancel on opened confirm or prompt
+// closes alert
+func (w *WebDriver) DismissAlertE() error {
+	err := w.WebClient.DismissAlertContext(w.Context(), w.SessionId)
+	if err != nil {
+		return driverError("dismiss alert", nil, err)
+	}
+
+	return nil
+}
+
+func (w *WebDriver) DismissAlert() {
+	must(w.DismissAlertE())
+}
+
+// SendAlertTextE
+// types text into opened prompt
+// prompt is not accepted
+//
+//	d.SendAlertText("gost")
+//	d.AcceptAlert()
+func (w *WebDriver) SendAlertTextE(text string) error {
+	err := w.WebClient.SendAlertTextContext(w.Context(), text, w.SessionId)
+	if err != nil {
+		return driverError("send alert text", nil, err)
+	}
+
+	return nil
+}
+
+func (w *WebDriver) SendAlertText(text string) {
+	must(w.SendAlertTextE(text))
+}
+
+// UnexpectedAlert
+// reports whether err is unexpected alert open error
+// returns alert text if driver sent it
+func UnexpectedAlert(err error) (string, bool) {
+	var wdErr *client.WebDriverError
+	if !errors.As(err, &wdErr) || !errors.Is(wdErr, client.ErrUnexpectedAlertOpen) {
+		return "", false
+	}
+
+	return wdErr.AlertText(), true
+}
@@ -0,0 +1,86 @@
+package driver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mcsymiv/gost/capabilities"
+	"github.com/mcsymiv/gost/client"
+	"github.com/mcsymiv/gost/driver"
+	"github.com/mcsymiv/gost/fake"
+)
+
+func TestAlerts(t *testing.T) {
+	d, srv := fake.Gost(t)
+
+	var accepted bool
+	var answer string
+	srv.Page(home,
+		fake.El("button", fake.Attr("id", "delete"), fake.OnClick(func(s *fake.Session, n *fake.Node) {
+			s.OpenDialog(&fake.Dialog{Type: "confirm", Text: "Delete?", OnClose: func(ok bool, text string) {
+				accepted = ok
+			}})
+		})),
+		fake.El("button", fake.Attr("id", "rename"), fake.OnClick(func(s *fake.Session, n *fake.Node) {
+			s.OpenDialog(&fake.Dialog{Type: "prompt", Text: "Name", OnClose: func(ok bool, text string) {
+				answer = text
+			}})
+		})),
+	)
+
+	d.Open(home)
+
+	if _, err := d.AlertTextE(); !errors.Is(err, client.ErrNoSuchAlert) {
+		t.Errorf("expected no such alert, got: %v", err)
+	}
+
+	d.Cl("#delete")
+	d.Wait(driver.AlertPresent())
+
+	if text := d.AlertText(); text != "Delete?" {
+		t.Errorf("unexpected alert text: %q", text)
+	}
+
+	d.AcceptAlert()
+	if !accepted {
+		t.Error("expected confirm to be accepted")
+	}
+
+	d.Cl("#rename")
+	d.SendAlertText("gost")
+	d.AcceptAlert()
+	if answer != "gost" {
+		t.Errorf("unexpected prompt answer: %q", answer)
+	}
+
+	d.Cl("#delete")
+	d.DismissAlert()
+	if accepted {
+		t.Error("expected confirm to be dismissed")
+	}
+}
+
+func TestUnexpectedAlert(t *testing.T) {
+	d, srv := fake.Gost(t, capabilities.UnhandledPromptBehavior(capabilities.PromptIgnore))
+	srv.Page(home,
+		fake.El("button", fake.Attr("id", "delete"), fake.OnClick(func(s *fake.Session, n *fake.Node) {
+			s.OpenDialog(&fake.Dialog{Type: "alert", Text: "Deleted"})
+		})),
+	)
+
+	d.Open(home)
+	d.Cl("#delete")
+
+	_, err := d.FE("#delete")
+	text, ok := driver.UnexpectedAlert(err)
+	if !ok || text != "Deleted" {
+		t.Fatalf("expected unexpected alert open with text, got: %q, %v", text, err)
+	}
+
+	if n := srv.Count("POST /session/{id}/element"); n != 2 {
+		t.Errorf("expected find not to be retried while alert is opened, got: %d requests", n)
+	}
+
+	d.DismissAlert()
+	d.F("#delete")
+}
@@ -0,0 +1,195 @@
+package driver
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// modifiers
+// keys held down by chords
+// sticky in send keys text until NullKey
+var modifiers = map[string]bool{
+	ShiftKey:   true,
+	ControlKey: true,
+	AltKey:     true,
+	MetaKey:    true,
+}
+
+// keyNames
+// shortcut key names, lower case
+// "mod" is resolved by platform
+var keyNames = map[string]string{
+	"ctrl":       ControlKey,
+	"control":    ControlKey,
+	"shift":      ShiftKey,
+	"alt":        AltKey,
+	"option":     AltKey,
+	"opt":        AltKey,
+	"meta":       MetaKey,
+	"cmd":        MetaKey,
+	"command":    MetaKey,
+	"super":      MetaKey,
+	"win":        MetaKey,
+	"enter":      EnterKey,
+	"return":     ReturnKey,
+	"tab":        TabKey,
+	"esc":        EscapeKey,
+	"escape":     EscapeKey,
+	"space":      SpaceKey,
+	"backspace":  BackspaceKey,
+	"delete":     DeleteKey,
+	"del":        DeleteKey,
+	"insert":     InsertKey,
+	"ins":        InsertKey,
+	"home":       HomeKey,
+	"end":        EndKey,
+	"pageup":     PageUpKey,
+	"pgup":       PageUpKey,
+	"pagedown":   PageDownKey,
+	"pgdn":       PageDownKey,
+	"up":         UpArrowKey,
+	"arrowup":    UpArrowKey,
+	"down":       DownArrowKey,
+	"arrowdown":  DownArrowKey,
+	"left":       LeftArrowKey,
+	"arrowleft":  LeftArrowKey,
+	"right":      RightArrowKey,
+	"arrowright": RightArrowKey,
+	"f1":         F1Key,
+	"f2":         F2Key,
+	"f3":         F3Key,
+	"f4":         F4Key,
+	"f5":         F5Key,
+	"f6":         F6Key,
+	"f7":         F7Key,
+	"f8":         F8Key,
+	"f9":         F9Key,
+	"f10":        F10Key,
+	"f11":        F11Key,
+	"f12":        F12Key,
+}
+
+// ParseShortcut
+// parses shortcut, i.e. "Ctrl+Shift+K", "Mod+A", "Shift+Tab"
+// into keys pressed in order
+// names are case insensitive, single letters are lower cased,
+// "Mod" is replaced with mod key, "+" key is written as "Ctrl++"
+func ParseShortcut(shortcut, mod string) ([]string, error) {
+	parts := strings.Split(shortcut, "+")
+
+	// trailing "+" key
+	if strings.HasSuffix(shortcut, "++") {
+		parts = append(parts[:len(parts)-2], "+")
+	}
+
+	keys := make([]string, 0, len(parts))
+	for _, p := range parts {
+		name := strings.TrimSpace(p)
+		if name == "" {
+			if p == "" {
+				return nil, fmt.Errorf("error on parse shortcut %q: empty key", shortcut)
+			}
+
+			name = p
+		}
+
+		switch {
+		case strings.EqualFold(name, "mod"):
+			keys = append(keys, mod)
+		case utf8.RuneCountInString(name) == 1:
+			keys = append(keys, strings.ToLower(name))
+		default:
+			key, ok := keyNames[strings.ToLower(name)]
+			if !ok {
+				return nil, fmt.Errorf("error on parse shortcut %q: unknown key %q", shortcut, name)
+			}
+
+			keys = append(keys, key)
+		}
+	}
+
+	return keys, nil
+}
+
+// Chord
+// send keys text pressing keys together
+// modifiers are released with NullKey
+//
+//	el.Input(driver.Chord(driver.ControlKey, "a"))
+func Chord(keys ...string) string {
+	return strings.Join(keys, "") + NullKey
+}
+
+// PlatformName
+// session platformName returned by driver
+// i.e. "mac", "linux", "windows"
+func (w *WebDriver) PlatformName() string {
+	p, _ := w.SessionCapabilities["platformName"].(string)
+	return strings.ToLower(p)
+}
+
+// ModKey
+// MetaKey on macOS session platform, ControlKey otherwise
+// used for "Mod" in shortcuts
+func (w *WebDriver) ModKey() string {
+	switch p := w.PlatformName(); {
+	case strings.HasPrefix(p, "mac"), p == "darwin", p == "ios":
+		return MetaKey
+	}
+
+	return ControlKey
+}
+
+// Chord
+// presses keys in order
+// and releases them in reverse order
+func (a *Actions) Chord(keys ...string) *Actions {
+	for _, k := range keys {
+		a.KeyDown(k)
+	}
+
+	for i := len(keys) - 1; i >= 0; i-- {
+		a.KeyUp(keys[i])
+	}
+
+	return a
+}
+
+// PressE
+// presses shortcut on focused element
+//
+//	d.Press("Ctrl+Shift+K")
+func (w *WebDriver) PressE(shortcut string) error {
+	keys, err := ParseShortcut(shortcut, w.ModKey())
+	if err != nil {
+		return driverError("press", nil, err)
+	}
+
+	return w.Actions().Chord(keys...).PerformE()
+}
+
+func (w *WebDriver) Press(shortcut string) {
+	must(w.PressE(shortcut))
+}
+
+// PressE
+// sends shortcut to element
+// with element send keys, which focuses element
+//
+//	el.Press("Mod+A")
+func (w *WebElement) PressE(shortcut string) (*WebElement, error) {
+	keys, err := ParseShortcut(shortcut, w.ModKey())
+	if err != nil {
+		return nil, driverError("press", w.WebElementSelector, err)
+	}
+
+	return w.InputE(Chord(keys...))
+}
+
+func (w *WebElement) Press(shortcut string) *WebElement {
+	el, err := w.PressE(shortcut)
+	must(err)
+
+	return el
+}
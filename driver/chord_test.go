@@ -0,0 +1,88 @@
+package driver_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mcsymiv/gost/driver"
+	"github.com/mcsymiv/gost/fake"
+)
+
+func TestParseShortcut(t *testing.T) {
+	shortcuts := map[string][]string{
+		"Ctrl+Shift+K": {driver.ControlKey, driver.ShiftKey, "k"},
+		"Mod+A":        {driver.MetaKey, "a"},
+		"shift+tab":    {driver.ShiftKey, driver.TabKey},
+		"Cmd+V":        {driver.MetaKey, "v"},
+		"Alt+F4":       {driver.AltKey, driver.F4Key},
+		"Ctrl++":       {driver.ControlKey, "+"},
+		"Esc":          {driver.EscapeKey},
+	}
+
+	for shortcut, want := range shortcuts {
+		keys, err := driver.ParseShortcut(shortcut, driver.MetaKey)
+		if err != nil || !reflect.DeepEqual(keys, want) {
+			t.Errorf("unexpected keys of %q: %q, %v", shortcut, keys, err)
+		}
+	}
+
+	for _, shortcut := range []string{"Ctrl+Hyper", "Ctrl+", ""} {
+		if _, err := driver.ParseShortcut(shortcut, driver.ControlKey); err == nil {
+			t.Errorf("expected error on %q", shortcut)
+		}
+	}
+}
+
+func TestPress(t *testing.T) {
+	d, srv := fake.Gost(t)
+	doc := srv.Page(home,
+		fake.El("input", fake.Attr("id", "q")),
+	)
+
+	d.Open(home)
+	d.F("#q").Click()
+
+	d.Keys("hi" + driver.Chord(driver.ShiftKey, driver.TabKey))
+	d.Press("Mod+A")
+	d.F("#q").Press("Ctrl+Shift+K").Input("!")
+
+	s := srv.Session(d.SessionId)
+	if got := strings.Join(s.Pressed, ","); got != "Control+a,Control+Shift+k" {
+		t.Errorf("unexpected pressed shortcuts: %s", got)
+	}
+
+	if v := doc.Find("#q").Attrs["value"]; v != "hi!" {
+		t.Errorf("unexpected value: %q", v)
+	}
+
+	// each key down is released
+	keys := s.Actions[0][0]["actions"].([]interface{})
+	var types []string
+	for _, a := range keys {
+		types = append(types, a.(map[string]interface{})["type"].(string))
+	}
+
+	if got := strings.Join(types, ","); got != "keyDown,keyUp,keyDown,keyUp,keyDown,keyDown,keyUp,keyUp" {
+		t.Errorf("unexpected key actions: %s", got)
+	}
+}
+
+func TestPressMac(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.PlatformName = "mac"
+	srv.Page(home, fake.El("input", fake.Attr("id", "q")))
+
+	// platform is read on session create
+	d.DriverSession()
+	if d.PlatformName() != "mac" || d.ModKey() != driver.MetaKey {
+		t.Fatalf("expected mac platform Meta mod key, got: %q", d.PlatformName())
+	}
+
+	d.Open(home)
+	d.F("#q").Press("Mod+A")
+
+	if s := srv.Session(d.SessionId); strings.Join(s.Pressed, ",") != "Meta+a" {
+		t.Errorf("unexpected pressed shortcuts: %v", s.Pressed)
+	}
+}
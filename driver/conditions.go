@@ -0,0 +1,401 @@
+package driver
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/mcsymiv/gost/client"
+	"github.com/mcsymiv/gost/config"
+)
+
+// Condition
+// named check polled by Wait
+// Check returns whether condition is met
+// and observed value reported on timeout
+//
+//	d.Wait(driver.Visible("#save"))
+//	d.Wait(driver.Or(driver.TextIs("#status", "Done"), driver.Present("#error")))
+type Condition struct {
+	Name  string
+	Check func(w *WebDriver) (bool, interface{}, error)
+}
+
+// WaitE
+// polls condition until it is met
+// opts override config WaitForTimeout, WaitForInterval
+// returns ErrWaitTimeout with condition name and last observed value
+func (w *WebDriver) WaitE(c Condition, opts ...WaitOption) error {
+	return w.poll("wait", c, opts...)
+}
+
+func (w *WebDriver) Wait(c Condition, opts ...WaitOption) {
+	must(w.WaitE(c, opts...))
+}
+
+// poll
+// checks condition on each interval until timeout
+// element lookups inside conditions are not retried by service
+func (w *WebDriver) poll(op string, c Condition, opts ...WaitOption) error {
+	ctx := w.waiting(opts...).Context()
+
+	timeout := config.Config.WaitForTimeout * time.Second
+	if d, ok := client.WaitTimeout(ctx); ok {
+		timeout = d
+	}
+
+	interval := config.Config.WaitForInterval * time.Millisecond
+	if d, ok := client.WaitInterval(ctx); ok && d > 0 {
+		interval = d
+	}
+
+	wd := w.waiting(NoWait())
+	end := time.Now().Add(timeout)
+
+	for {
+		ok, v, err := c.Check(wd)
+		if err != nil {
+			return driverError(op, nil, fmt.Errorf("%s: %w", c.Name, err))
+		}
+
+		if ok {
+			return nil
+		}
+
+		if time.Now().After(end) {
+			return driverError(op, nil, fmt.Errorf("%w: %s, last value: %v", ErrWaitTimeout, c.Name, v))
+		}
+
+		select {
+		case <-w.Context().Done():
+			return driverError(op, nil, w.Context().Err())
+		case <-time.After(interval):
+		}
+	}
+}
+
+// missing
+// element lookup errors
+// treated as condition not met
+func missing(err error) bool {
+	return errors.Is(err, client.ErrNoSuchElement) || errors.Is(err, client.ErrStaleElementReference)
+}
+
+// first
+// finds first element for element conditions
+// nil element if none
+func first(w *WebDriver, s string) (*WebElement, error) {
+	els, err := w.FsE(s)
+	if err != nil {
+		if missing(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if len(els) == 0 {
+		return nil, nil
+	}
+
+	return els[0], nil
+}
+
+// element
+// builds condition on first element found by selector
+// fn is not called if element is not present
+func element(name, s string, fn func(el *WebElement) (bool, interface{}, error)) Condition {
+	return Condition{
+		Name: name,
+		Check: func(w *WebDriver) (bool, interface{}, error) {
+			el, err := first(w, s)
+			if err != nil {
+				return false, nil, err
+			}
+
+			if el == nil {
+				return false, "not present", nil
+			}
+
+			ok, v, err := fn(el)
+			if err != nil && missing(err) {
+				return false, err.Error(), nil
+			}
+
+			return ok, v, err
+		},
+	}
+}
+
+// Present
+// element is found
+func Present(s string) Condition {
+	return element(fmt.Sprintf("present(%q)", s), s, func(el *WebElement) (bool, interface{}, error) {
+		return true, "present", nil
+	})
+}
+
+// Absent
+// element is not found
+func Absent(s string) Condition {
+	return Not(Present(s))
+}
+
+// Visible
+// element is found and displayed
+func Visible(s string) Condition {
+	return element(fmt.Sprintf("visible(%q)", s), s, func(el *WebElement) (bool, interface{}, error) {
+		ok, err := el.IsDisplayedE()
+		return ok, ok, err
+	})
+}
+
+// Invisible
+// element is not found or not displayed
+func Invisible(s string) Condition {
+	return Not(Visible(s))
+}
+
+// Clickable
+// element is displayed and not disabled
+func Clickable(s string) Condition {
+	return element(fmt.Sprintf("clickable(%q)", s), s, func(el *WebElement) (bool, interface{}, error) {
+		ok, err := el.IsDisplayedE()
+		if err != nil || !ok {
+			return false, "not displayed", err
+		}
+
+		enabled, err := el.IsEnabledE()
+		if err != nil {
+			return false, nil, err
+		}
+
+		if !enabled {
+			return false, "disabled", nil
+		}
+
+		return true, "clickable", nil
+	})
+}
+
+// TextIs
+// element text equals text
+func TextIs(s, text string) Condition {
+	return element(fmt.Sprintf("text(%q) == %q", s, text), s, func(el *WebElement) (bool, interface{}, error) {
+		txt, err := el.TextE()
+		return txt == text, txt, err
+	})
+}
+
+// TextContains
+// element text contains substring
+func TextContains(s, sub string) Condition {
+	return element(fmt.Sprintf("text(%q) contains %q", s, sub), s, func(el *WebElement) (bool, interface{}, error) {
+		txt, err := el.TextE()
+		return strings.Contains(txt, sub), txt, err
+	})
+}
+
+// TextMatches
+// element text matches regexp pattern
+func TextMatches(s, pattern string) Condition {
+	re := regexp.MustCompile(pattern)
+
+	return element(fmt.Sprintf("text(%q) matches %q", s, pattern), s, func(el *WebElement) (bool, interface{}, error) {
+		txt, err := el.TextE()
+		return re.MatchString(txt), txt, err
+	})
+}
+
+// AttrIs
+// element attribute equals value
+func AttrIs(s, attr, value string) Condition {
+	return element(fmt.Sprintf("attr(%q, %q) == %q", s, attr, value), s, func(el *WebElement) (bool, interface{}, error) {
+		a, err := el.AttrE(attr)
+		return a == value, a, err
+	})
+}
+
+// Count
+// number of elements found equals n
+func Count(s string, n int) Condition {
+	return Condition{
+		Name: fmt.Sprintf("count(%q) == %d", s, n),
+		Check: func(w *WebDriver) (bool, interface{}, error) {
+			els, err := w.FsE(s)
+			if err != nil && !missing(err) {
+				return false, nil, err
+			}
+
+			return len(els) == n, len(els), nil
+		},
+	}
+}
+
+// TabCount
+// number of opened tabs equals n
+func TabCount(n int) Condition {
+	return Condition{
+		Name: fmt.Sprintf("tabs == %d", n),
+		Check: func(w *WebDriver) (bool, interface{}, error) {
+			tabs, err := w.TabsE()
+			return len(tabs) == n, len(tabs), err
+		},
+	}
+}
+
+// URLMatches
+// current url matches regexp pattern
+func URLMatches(pattern string) Condition {
+	re := regexp.MustCompile(pattern)
+
+	return Condition{
+		Name: fmt.Sprintf("url matches %q", pattern),
+		Check: func(w *WebDriver) (bool, interface{}, error) {
+			u, err := w.CurrentURLE()
+			return re.MatchString(u), u, err
+		},
+	}
+}
+
+// TitleMatches
+// page title matches regexp pattern
+func TitleMatches(pattern string) Condition {
+	re := regexp.MustCompile(pattern)
+
+	return Condition{
+		Name: fmt.Sprintf("title matches %q", pattern),
+		Check: func(w *WebDriver) (bool, interface{}, error) {
+			t, err := w.TitleE()
+			return re.MatchString(t), t, err
+		},
+	}
+}
+
+// AlertPresent
+// alert, confirm or prompt is opened
+func AlertPresent() Condition {
+	return Condition{
+		Name: "alert present",
+		Check: func(w *WebDriver) (bool, interface{}, error) {
+			text, err := w.AlertTextE()
+			if errors.Is(err, client.ErrNoSuchAlert) {
+				return false, "no alert", nil
+			}
+
+			return err == nil, text, err
+		},
+	}
+}
+
+// JS
+// script result is truthy
+//
+//	driver.JS("return document.readyState === 'complete'")
+func JS(script string, args ...interface{}) Condition {
+	return Condition{
+		Name: fmt.Sprintf("js(%q)", script),
+		Check: func(w *WebDriver) (bool, interface{}, error) {
+			v, err := w.ExecuteScriptE(script, args...)
+			return truthy(v), v, err
+		},
+	}
+}
+
+// truthy
+// JS truthiness of decoded script result
+func truthy(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return t
+	case float64:
+		return t != 0
+	case string:
+		return t != ""
+	}
+
+	return true
+}
+
+// Func
+// wraps predicate into named condition
+func Func(name string, fn func() bool) Condition {
+	return Condition{
+		Name: name,
+		Check: func(w *WebDriver) (bool, interface{}, error) {
+			ok := fn()
+			return ok, ok, nil
+		},
+	}
+}
+
+// And
+// all conditions are met
+// reports value of first unmet condition
+func And(conds ...Condition) Condition {
+	return Condition{
+		Name: join("and", conds),
+		Check: func(w *WebDriver) (bool, interface{}, error) {
+			for _, c := range conds {
+				ok, v, err := c.Check(w)
+				if err != nil || !ok {
+					return false, fmt.Sprintf("%s: %v", c.Name, v), err
+				}
+			}
+
+			return true, nil, nil
+		},
+	}
+}
+
+// Or
+// any of conditions is met
+// reports values of all conditions
+func Or(conds ...Condition) Condition {
+	return Condition{
+		Name: join("or", conds),
+		Check: func(w *WebDriver) (bool, interface{}, error) {
+			var values []string
+
+			for _, c := range conds {
+				ok, v, err := c.Check(w)
+				if err != nil {
+					return false, nil, err
+				}
+
+				if ok {
+					return true, v, nil
+				}
+
+				values = append(values, fmt.Sprintf("%s: %v", c.Name, v))
+			}
+
+			return false, strings.Join(values, "; "), nil
+		},
+	}
+}
+
+// Not
+// condition is not met
+func Not(c Condition) Condition {
+	return Condition{
+		Name: fmt.Sprintf("not(%s)", c.Name),
+		Check: func(w *WebDriver) (bool, interface{}, error) {
+			ok, v, err := c.Check(w)
+			return !ok && err == nil, v, err
+		},
+	}
+}
+
+func join(op string, conds []Condition) string {
+	var names []string
+	for _, c := range conds {
+		names = append(names, c.Name)
+	}
+
+	return fmt.Sprintf("%s(%s)", op, strings.Join(names, ", "))
+}
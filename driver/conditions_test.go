@@ -0,0 +1,83 @@
+package driver_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mcsymiv/gost/driver"
+	"github.com/mcsymiv/gost/fake"
+)
+
+func TestWaitConditions(t *testing.T) {
+	d, srv := fake.Gost(t)
+	doc := srv.Page(home,
+		fake.El("div", fake.Attr("id", "spinner")),
+		fake.El("span", fake.Attr("id", "status"), fake.Text("Loading")),
+		fake.El("button", fake.Attr("id", "save"), fake.Attr("disabled", "true"), fake.Hidden()),
+	)
+
+	d.Open(home)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		srv.Update(func() {
+			doc.Find("#spinner").Remove()
+			doc.Find("#status").Text = "Saved 3 items"
+
+			save := doc.Find("#save")
+			save.Hidden = false
+			delete(save.Attrs, "disabled")
+		})
+	}()
+
+	conds := []driver.Condition{
+		driver.Absent("#spinner"),
+		driver.Visible("#save"),
+		driver.Clickable("#save"),
+		driver.TextMatches("#status", `Saved \d+ items`),
+		driver.And(driver.TextContains("#status", "Saved"), driver.Not(driver.Present("#error"))),
+		driver.Or(driver.Present("#error"), driver.Count("//button", 1)),
+		driver.URLMatches(`^https://fake\.test`),
+		driver.TabCount(1),
+	}
+
+	for _, c := range conds {
+		if err := d.WaitE(c); err != nil {
+			t.Errorf("unexpected error on %s: %v", c.Name, err)
+		}
+	}
+
+	if n := srv.Count("POST /session/{id}/elements"); n < 2 {
+		t.Errorf("expected polled find requests, got: %d", n)
+	}
+}
+
+func TestWaitTimeout(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home, fake.El("span", fake.Attr("id", "status"), fake.Text("Loading")))
+
+	d.Open(home)
+
+	start := time.Now()
+	err := d.WaitE(driver.TextIs("#status", "Done"), driver.Timeout(200*time.Millisecond))
+	if !errors.Is(err, driver.ErrWaitTimeout) {
+		t.Fatalf("expected wait timeout, got: %v", err)
+	}
+
+	if since := time.Since(start); since > time.Second {
+		t.Errorf("expected wait to honour timeout option, took: %s", since)
+	}
+
+	for _, want := range []string{`text("#status") == "Done"`, "last value: Loading"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected %q in error: %v", want, err)
+		}
+	}
+
+	err = d.WaitE(driver.Visible("#missing"), driver.NoWait())
+	if err == nil || !strings.Contains(err.Error(), "last value: not present") {
+		t.Errorf("expected not present value, got: %v", err)
+	}
+}
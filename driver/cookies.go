@@ -0,0 +1,145 @@
+package driver
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/mcsymiv/gost/data"
+)
+
+// GetCookiesE
+// returns cookies visible to current page
+func (w *WebDriver) GetCookiesE() ([]*data.Cookie, error) {
+	cookies, err := w.WebClient.GetCookiesContext(w.Context(), w.SessionId)
+	if err != nil {
+		return nil, driverError("get cookies", nil, err)
+	}
+
+	return cookies, nil
+}
+
+func (w *WebDriver) GetCookies() []*data.Cookie {
+	cookies, err := w.GetCookiesE()
+	must(err)
+
+	return cookies
+}
+
+// GetCookieE
+// returns named cookie
+// client.ErrNoSuchCookie if not set
+func (w *WebDriver) GetCookieE(name string) (*data.Cookie, error) {
+	cookie, err := w.WebClient.GetCookieContext(w.Context(), name, w.SessionId)
+	if err != nil {
+		return nil, driverError(fmt.Sprintf("get cookie %q", name), nil, err)
+	}
+
+	return cookie, nil
+}
+
+func (w *WebDriver) GetCookie(name string) *data.Cookie {
+	cookie, err := w.GetCookieE(name)
+	must(err)
+
+	return cookie
+}
+
+// AddCookieE
+// adds cookie to current page domain
+// page of cookie domain has to be opened
+func (w *WebDriver) AddCookieE(cookie *data.Cookie) error {
+	err := w.WebClient.AddCookieContext(w.Context(), cookie, w.SessionId)
+	if err != nil {
+		return driverError(fmt.Sprintf("add cookie %q", cookie.Name), nil, err)
+	}
+
+	return nil
+}
+
+func (w *WebDriver) AddCookie(cookie *data.Cookie) {
+	must(w.AddCookieE(cookie))
+}
+
+func (w *WebDriver) DeleteCookieE(name string) error {
+	err := w.WebClient.DeleteCookieContext(w.Context(), name, w.SessionId)
+	if err != nil {
+		return driverError(fmt.Sprintf("delete cookie %q", name), nil, err)
+	}
+
+	return nil
+}
+
+func (w *WebDriver) DeleteCookie(name string) {
+	must(w.DeleteCookieE(name))
+}
+
+func (w *WebDriver) DeleteAllCookiesE() error {
+	err := w.WebClient.DeleteAllCookiesContext(w.Context(), w.SessionId)
+	if err != nil {
+		return driverError("delete cookies", nil, err)
+	}
+
+	return nil
+}
+
+func (w *WebDriver) DeleteAllCookies() {
+	must(w.DeleteAllCookiesE())
+}
+
+// SaveCookiesE
+// writes current page cookies to JSON file
+// i.e. after login to reuse session in next test run
+func (w *WebDriver) SaveCookiesE(name string) error {
+	cookies, err := w.GetCookiesE()
+	if err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(cookies, "", "  ")
+	if err != nil {
+		return driverError("save cookies", nil, err)
+	}
+
+	if err := os.WriteFile(name, b, 0600); err != nil {
+		return driverError("save cookies", nil, err)
+	}
+
+	return nil
+}
+
+func (w *WebDriver) SaveCookies(name string) {
+	must(w.SaveCookiesE(name))
+}
+
+// LoadCookiesE
+// adds cookies from JSON file saved by SaveCookies
+// page of cookies domain has to be opened,
+// refresh page to apply them
+//
+//	d.Open("https://app.test")
+//	d.LoadCookies("../cookies.json")
+//	d.Refresh()
+func (w *WebDriver) LoadCookiesE(name string) error {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return driverError("load cookies", nil, err)
+	}
+
+	var cookies []*data.Cookie
+	if err := json.Unmarshal(b, &cookies); err != nil {
+		return driverError("load cookies", nil, err)
+	}
+
+	for _, cookie := range cookies {
+		if err := w.AddCookieE(cookie); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *WebDriver) LoadCookies(name string) {
+	must(w.LoadCookiesE(name))
+}
@@ -0,0 +1,71 @@
+package driver_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/mcsymiv/gost/client"
+	"github.com/mcsymiv/gost/data"
+	"github.com/mcsymiv/gost/fake"
+)
+
+func TestCookies(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home)
+
+	d.Open(home)
+	d.AddCookie(&data.Cookie{Name: "session", Value: "abc", HttpOnly: true})
+	d.AddCookie(&data.Cookie{Name: "theme", Value: "dark"})
+
+	if c := d.GetCookie("session"); c.Value != "abc" || c.Domain != "fake.test" || !c.HttpOnly {
+		t.Errorf("unexpected cookie: %+v", c)
+	}
+
+	jar := filepath.Join(t.TempDir(), "cookies.json")
+	d.SaveCookies(jar)
+
+	d.DeleteCookie("theme")
+	if _, err := d.GetCookieE("theme"); !errors.Is(err, client.ErrNoSuchCookie) {
+		t.Errorf("expected no such cookie, got: %v", err)
+	}
+
+	d.DeleteAllCookies()
+	if n := len(d.GetCookies()); n != 0 {
+		t.Fatalf("expected no cookies, got: %d", n)
+	}
+
+	d.LoadCookies(jar)
+	if n := len(d.GetCookies()); n != 2 {
+		t.Errorf("expected 2 loaded cookies, got: %d", n)
+	}
+
+	d.Open("about:blank")
+	if err := d.AddCookieE(&data.Cookie{Name: "session", Value: "abc"}); !errors.Is(err, client.ErrInvalidCookieDomain) {
+		t.Errorf("expected invalid cookie domain, got: %v", err)
+	}
+}
+
+func TestCookieEscapedName(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home)
+
+	d.Open(home)
+
+	name := "a/b %2F c"
+	d.AddCookie(&data.Cookie{Name: name, Value: "escaped"})
+	d.AddCookie(&data.Cookie{Name: "a", Value: "plain"})
+
+	if c := d.GetCookie(name); c.Name != name || c.Value != "escaped" {
+		t.Errorf("unexpected cookie: %+v", c)
+	}
+
+	d.DeleteCookie(name)
+	if _, err := d.GetCookieE(name); !errors.Is(err, client.ErrNoSuchCookie) {
+		t.Errorf("expected no such cookie, got: %v", err)
+	}
+
+	if c := d.GetCookie("a"); c.Value != "plain" {
+		t.Errorf("unexpected cookie: %+v", c)
+	}
+}
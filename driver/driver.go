@@ -1,8 +1,7 @@
 package driver
 
 import (
-	"fmt"
-	"os"
+	"context"
 	"os/exec"
 	"time"
 
@@ -11,6 +10,7 @@ import (
 	"github.com/mcsymiv/gost/command"
 	"github.com/mcsymiv/gost/config"
 	"github.com/mcsymiv/gost/data"
+	"github.com/mcsymiv/gost/js"
 )
 
 type Element interface{}
@@ -20,6 +20,16 @@ type WebDriver struct {
 	WebClient    *client.WebClient
 	Capabilities *capabilities.Capabilities
 	SessionId    string
+
+	// SessionCapabilities
+	// returned by driver on session create
+	// i.e. platformName, browserVersion
+	SessionCapabilities map[string]interface{}
+
+	// ctx
+	// bound by WithContext
+	// cancels in-flight driver calls
+	ctx context.Context
 }
 
 type WebElement struct {
@@ -28,7 +38,51 @@ type WebElement struct {
 	WebElementSelector *data.Selector
 }
 
-func NewDriver(capsFn ...capabilities.CapabilitiesFunc) *WebDriver {
+// Context
+// returns driver context
+// context.Background if none is bound
+func (w *WebDriver) Context() context.Context {
+	if w.ctx == nil {
+		return context.Background()
+	}
+
+	return w.ctx
+}
+
+// WithContext
+// returns shallow copy of driver bound to ctx
+// elements found with the copy share ctx
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+//	defer cancel()
+//	d.WithContext(ctx).Cl("Save")
+func (w *WebDriver) WithContext(ctx context.Context) *WebDriver {
+	wd := *w
+	wd.ctx = ctx
+
+	return &wd
+}
+
+// WithTimeout
+// returns driver copy with per-call deadline
+// cancel releases timer resources
+func (w *WebDriver) WithTimeout(timeout time.Duration) (*WebDriver, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(w.Context(), timeout)
+	return w.WithContext(ctx), cancel
+}
+
+// WithContext
+// returns element copy bound to ctx
+func (w *WebElement) WithContext(ctx context.Context) *WebElement {
+	el := *w
+	el.WebDriver = w.WebDriver.WithContext(ctx)
+
+	return &el
+}
+
+// NewDriverE
+// creates new session on already running driver
+func NewDriverE(capsFn ...capabilities.CapabilitiesFunc) (*WebDriver, error) {
 	caps := capabilities.DefaultCapabilities()
 	for _, capFn := range capsFn {
 		capFn(caps)
@@ -37,28 +91,47 @@ func NewDriver(capsFn ...capabilities.CapabilitiesFunc) *WebDriver {
 	webclient := client.NewClient()
 	session, err := webclient.Session(caps)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, driverError("session create", nil, err)
 	}
 
 	return &WebDriver{
-		Capabilities: caps,
-		WebClient:    webclient,
-		SessionId:    session.Id,
+		Capabilities:        caps,
+		WebClient:           webclient,
+		SessionId:           session.Id,
+		SessionCapabilities: session.Capabilities,
+	}, nil
+}
+
+func NewDriver(capsFn ...capabilities.CapabilitiesFunc) *WebDriver {
+	wd, err := NewDriverE(capsFn...)
+	must(err)
+
+	return wd
+}
+
+func (w *WebDriver) DriverSessionE() (*WebDriver, error) {
+	session, err := w.WebClient.Session(w.Capabilities)
+	if err != nil {
+		return nil, driverError("session create", nil, err)
 	}
+
+	w.SessionId = session.Id
+	w.SessionCapabilities = session.Capabilities
+	return w, nil
 }
 
 func (w *WebDriver) DriverSession() *WebDriver {
-	session, err := w.WebClient.Session(w.Capabilities)
+	wd, err := w.DriverSessionE()
 	if err != nil {
 		return nil
 	}
 
-	w.SessionId = session.Id
-	return w
+	return wd
 }
 
-func Driver(capsFn ...capabilities.CapabilitiesFunc) *WebDriver {
+// DriverE
+// starts driver command and creates new session
+func DriverE(capsFn ...capabilities.CapabilitiesFunc) (*WebDriver, error) {
 	caps := capabilities.DefaultCapabilities()
 	for _, capFn := range capsFn {
 		capFn(caps)
@@ -68,108 +141,237 @@ func Driver(capsFn ...capabilities.CapabilitiesFunc) *WebDriver {
 
 	exec, err := command.Cmd(caps, config.Config)
 	if err != nil {
-		panic(fmt.Sprintf("error on starting driver command: %v", err))
+		return nil, driverError("starting driver command", nil, err)
 	}
 
 	session, err := webclient.Session(caps)
 	if err != nil {
-		panic(fmt.Sprintf("error on session create: %v", err))
+		return nil, driverError("session create", nil, err)
 	}
 
 	return &WebDriver{
-		Command:      exec,
-		Capabilities: caps,
-		WebClient:    webclient,
-		SessionId:    session.Id,
+		Command:             exec,
+		Capabilities:        caps,
+		WebClient:           webclient,
+		SessionId:           session.Id,
+		SessionCapabilities: session.Capabilities,
+	}, nil
+}
+
+func Driver(capsFn ...capabilities.CapabilitiesFunc) *WebDriver {
+	wd, err := DriverE(capsFn...)
+	must(err)
+
+	return wd
+}
+
+func (w *WebDriver) UrlE(u string) (string, error) {
+	url, err := w.WebClient.UrlContext(w.Context(), u, w.SessionId)
+	if err != nil {
+		return "", driverError("url", nil, err)
 	}
+
+	return url.Url, nil
 }
 
 func (w *WebDriver) Url(u string) string {
-	url, err := w.WebClient.Url(u, w.SessionId)
+	url, err := w.UrlE(u)
+	must(err)
+
+	return url
+}
+
+func (w *WebDriver) OpenE(u string) (string, error) {
+	url, err := w.WebClient.OpenContext(w.Context(), u, w.SessionId)
 	if err != nil {
-		fmt.Printf("error on url: %v", err)
-		return ""
+		return "", driverError("open", nil, err)
 	}
 
-	return url.Url
+	return url.Url, nil
 }
 
 func (w *WebDriver) Open(u string) string {
-	url, err := w.WebClient.Open(u, w.SessionId)
+	url, err := w.OpenE(u)
+	must(err)
+
+	return url
+}
+
+// NewTabE
+// opens new tab, returns its handle
+// current window is not switched
+func (w *WebDriver) NewTabE() (string, error) {
+	handle, err := w.WebClient.NewTabContext(w.Context(), w.SessionId)
 	if err != nil {
-		fmt.Printf("error on open: %v", err)
-		return ""
+		return "", driverError("new tab", nil, err)
 	}
 
-	return url.Url
+	return handle, nil
 }
 
-func (w *WebDriver) NewTab() {
-	err := w.WebClient.NewTab(w.SessionId)
+func (w *WebDriver) NewTab() string {
+	handle, err := w.NewTabE()
+	must(err)
+
+	return handle
+}
+
+func (w *WebDriver) TabsE() ([]string, error) {
+	tabs, err := w.WebClient.TabsContext(w.Context(), w.SessionId)
 	if err != nil {
-		panic(fmt.Sprintf("error on new tabs: %v", err))
+		return nil, driverError("tabs", nil, err)
 	}
+
+	return tabs, nil
 }
 
 func (w *WebDriver) Tabs() []string {
-	tabs, err := w.WebClient.Tabs(w.SessionId)
+	tabs, err := w.TabsE()
+	must(err)
+
+	return tabs
+}
+
+func (w *WebDriver) TabE(n int) error {
+	err := w.WebClient.TabContext(w.Context(), n, w.SessionId)
 	if err != nil {
-		panic(fmt.Sprintf("error on tabs: %v", err))
+		return driverError("tab", nil, err)
 	}
 
-	return tabs
+	return nil
 }
 
 func (w *WebDriver) Tab(n int) {
-	err := w.WebClient.Tab(n, w.SessionId)
+	must(w.TabE(n))
+}
+
+// CurrentURLE
+// returns url of current page
+func (w *WebDriver) CurrentURLE() (string, error) {
+	u, err := w.WebClient.CurrentURLContext(w.Context(), w.SessionId)
 	if err != nil {
-		panic(fmt.Sprintf("error on tab: %v", err))
+		return "", driverError("current url", nil, err)
 	}
+
+	return u, nil
 }
 
-func (w *WebDriver) Quit() {
-	err := w.WebClient.Quit(w.SessionId)
+func (w *WebDriver) CurrentURL() string {
+	u, err := w.CurrentURLE()
+	must(err)
+
+	return u
+}
+
+// TitleE
+// returns title of current page
+func (w *WebDriver) TitleE() (string, error) {
+	t, err := w.WebClient.TitleContext(w.Context(), w.SessionId)
 	if err != nil {
-		panic(fmt.Sprintf("error on quit: %v", err))
+		return "", driverError("title", nil, err)
 	}
+
+	return t, nil
 }
 
-func (w *WebDriver) FindElement(selector *data.Selector) *WebElement {
-	eId, err := w.WebClient.FindElement(selector, w.SessionId)
+func (w *WebDriver) Title() string {
+	t, err := w.TitleE()
+	must(err)
+
+	return t
+}
+
+// BackE
+// navigates back in history
+func (w *WebDriver) BackE() error {
+	err := w.WebClient.BackContext(w.Context(), w.SessionId)
 	if err != nil {
-		panic(fmt.Sprintf("error on find element: %v", err))
+		return driverError("back", nil, err)
 	}
 
-	return &WebElement{
-		WebDriver:    w,
-		WebElementId: eId,
+	return nil
+}
+
+func (w *WebDriver) Back() {
+	must(w.BackE())
+}
+
+// ForwardE
+// navigates forward in history
+func (w *WebDriver) ForwardE() error {
+	err := w.WebClient.ForwardContext(w.Context(), w.SessionId)
+	if err != nil {
+		return driverError("forward", nil, err)
 	}
+
+	return nil
 }
 
-func (w *WebDriver) FindElements(selector *data.Selector) []*WebElement {
-	elementsId, err := w.WebClient.FindElements(selector, w.SessionId)
+func (w *WebDriver) Forward() {
+	must(w.ForwardE())
+}
+
+// RefreshE
+// reloads current page
+// found elements become stale
+func (w *WebDriver) RefreshE() error {
+	err := w.WebClient.RefreshContext(w.Context(), w.SessionId)
 	if err != nil {
-		panic(fmt.Sprintf("error on find elements: %v", err))
+		return driverError("refresh", nil, err)
 	}
 
-	var els []*WebElement
+	return nil
+}
 
-	for _, id := range elementsId {
-		els = append(els, &WebElement{
-			WebDriver:          w,
-			WebElementId:       id,
-			WebElementSelector: selector,
-		})
+func (w *WebDriver) Refresh() {
+	must(w.RefreshE())
+}
+
+func (w *WebDriver) QuitE() error {
+	err := w.WebClient.QuitContext(w.Context(), w.SessionId)
+	if err != nil {
+		return driverError("quit", nil, err)
 	}
 
-	return els
+	return nil
+}
+
+func (w *WebDriver) Quit() {
+	must(w.QuitE())
+}
+
+func (w *WebDriver) FindElementE(selector *data.Selector) (*WebElement, error) {
+	if selector.Using == data.ByPierce {
+		return w.pierceElement(selector)
+	}
+
+	eId, err := w.WebClient.FindElementContext(w.Context(), selector, w.SessionId)
+	if err != nil {
+		return nil, driverError("find element", selector, err)
+	}
+
+	return &WebElement{
+		WebDriver:          w,
+		WebElementId:       eId,
+		WebElementSelector: selector,
+	}, nil
+}
+
+func (w *WebDriver) FindElement(selector *data.Selector) *WebElement {
+	el, err := w.FindElementE(selector)
+	must(err)
+
+	return el
 }
 
-func (w *WebDriver) Fs(s string) []*WebElement {
-	selector := Strategy(s)
-	elementsId, err := w.WebClient.FindElements(selector, w.SessionId)
+func (w *WebDriver) FindElementsE(selector *data.Selector) ([]*WebElement, error) {
+	if selector.Using == data.ByPierce {
+		return w.pierceElements(selector)
+	}
+
+	elementsId, err := w.WebClient.FindElementsContext(w.Context(), selector, w.SessionId)
 	if err != nil {
-		panic(fmt.Sprintf("error on find element: %v", err))
+		return nil, driverError("find elements", selector, err)
 	}
 
 	var els []*WebElement
@@ -182,46 +384,92 @@ func (w *WebDriver) Fs(s string) []*WebElement {
 		})
 	}
 
+	return els, nil
+}
+
+func (w *WebDriver) FindElements(selector *data.Selector) []*WebElement {
+	els, err := w.FindElementsE(selector)
+	must(err)
+
 	return els
 }
 
-func (w *WebDriver) F(s string) *WebElement {
-	selector := Strategy(s)
-	eId, err := w.WebClient.FindElement(selector, w.SessionId)
+// FsE
+// finds elements
+// opts override service wait for this call
+func (w *WebDriver) FsE(s string, opts ...WaitOption) ([]*WebElement, error) {
+	els, err := w.waiting(opts...).FindElementsE(Strategy(s))
 	if err != nil {
-		panic(fmt.Sprintf("error on find element: %v", err))
+		return nil, err
 	}
 
-	return &WebElement{
-		WebDriver:          w,
-		WebElementId:       eId,
-		WebElementSelector: selector,
+	for _, el := range els {
+		el.WebDriver = w
 	}
+
+	return els, nil
 }
 
-// Next
+func (w *WebDriver) Fs(s string, opts ...WaitOption) []*WebElement {
+	els, err := w.FsE(s, opts...)
+	must(err)
+
+	return els
+}
+
+// FE
+// finds element
+// opts override service wait for this call
+func (w *WebDriver) FE(s string, opts ...WaitOption) (*WebElement, error) {
+	el, err := w.waiting(opts...).FindElementE(Strategy(s))
+	if err != nil {
+		return nil, err
+	}
+
+	el.WebDriver = w
+
+	return el, nil
+}
+
+func (w *WebDriver) F(s string, opts ...WaitOption) *WebElement {
+	el, err := w.FE(s, opts...)
+	must(err)
+
+	return el
+}
+
+// NextE
 // finds xpath element from element
-func (w *WebElement) Next(s string) *WebElement {
+func (w *WebElement) NextE(s string, opts ...WaitOption) (*WebElement, error) {
 	by := NextStrategy(s)
 
-	eId, err := w.WebClient.FromElement(by, w.SessionId, w.WebElementId)
+	eId, err := w.WebClient.FromElementContext(w.waiting(opts...).Context(), by, w.SessionId, w.WebElementId)
 	if err != nil {
-		panic(fmt.Sprintf("error on find element: %v", err))
+		return nil, driverError("find element", by, err)
 	}
 
 	return &WebElement{
 		WebDriver:          w.WebDriver,
 		WebElementId:       eId,
 		WebElementSelector: by,
-	}
+	}, nil
+}
+
+// Next
+// finds xpath element from element
+func (w *WebElement) Next(s string, opts ...WaitOption) *WebElement {
+	el, err := w.NextE(s, opts...)
+	must(err)
+
+	return el
 }
 
-func (w *WebElement) Nexts(s string) []*WebElement {
+func (w *WebElement) NextsE(s string, opts ...WaitOption) ([]*WebElement, error) {
 	by := NextStrategy(s)
 
-	elementsId, err := w.WebClient.FromElements(by, w.SessionId, w.WebElementId)
+	elementsId, err := w.WebClient.FromElementsContext(w.waiting(opts...).Context(), by, w.SessionId, w.WebElementId)
 	if err != nil {
-		panic(fmt.Sprintf("error on find element: %v", err))
+		return nil, driverError("find elements", by, err)
 	}
 
 	var els []*WebElement
@@ -234,138 +482,240 @@ func (w *WebElement) Nexts(s string) []*WebElement {
 		})
 	}
 
+	return els, nil
+}
+
+func (w *WebElement) Nexts(s string, opts ...WaitOption) []*WebElement {
+	els, err := w.NextsE(s, opts...)
+	must(err)
+
 	return els
 }
 
-// P
-// invokes parent function
+// UpE
+// finds parent element
 // with N-level times
-func (w *WebElement) Up(level int) *WebElement {
+func (w *WebElement) UpE(level int) (*WebElement, error) {
 	by := PXpathStrategy(level, w.WebElementSelector)
 
-	eId, err := w.WebClient.FromElement(by, w.SessionId, w.WebElementId)
+	eId, err := w.WebClient.FromElementContext(w.Context(), by, w.SessionId, w.WebElementId)
 	if err != nil {
-		panic(fmt.Sprintf("error on find element: %v", err))
+		return nil, driverError("find element", by, err)
 	}
 
 	return &WebElement{
 		WebDriver:          w.WebDriver,
 		WebElementId:       eId,
 		WebElementSelector: by,
-	}
+	}, nil
 }
 
-func (w *WebElement) Parent() *WebElement {
+// Up
+// invokes parent function
+// with N-level times
+func (w *WebElement) Up(level int) *WebElement {
+	el, err := w.UpE(level)
+	must(err)
+
+	return el
+}
+
+func (w *WebElement) ParentE() (*WebElement, error) {
 	by := ParentXpathStrategy(w.WebElementSelector)
 
-	eId, err := w.WebClient.FromElement(by, w.SessionId, w.WebElementId)
+	eId, err := w.WebClient.FromElementContext(w.Context(), by, w.SessionId, w.WebElementId)
 	if err != nil {
-		panic(fmt.Sprintf("error on find element: %v", err))
+		return nil, driverError("find element", by, err)
 	}
 
 	return &WebElement{
 		WebDriver:          w.WebDriver,
 		WebElementId:       eId,
 		WebElementSelector: by,
-	}
+	}, nil
 }
 
-func (w *WebElement) IsDisplayed() bool {
-	ok, err := w.WebClient.IsDisplayed(w.SessionId, w.WebElementId)
-	fmt.Println(ok)
+func (w *WebElement) Parent() *WebElement {
+	el, err := w.ParentE()
+	must(err)
+
+	return el
+}
+
+func (w *WebElement) IsDisplayedE() (bool, error) {
+	ok, err := w.WebClient.IsDisplayedContext(w.Context(), w.SessionId, w.WebElementId)
 	if err != nil {
-		panic(fmt.Sprintf("error on isdisplayed: %v", err))
+		return false, driverError("isdisplayed", w.WebElementSelector, err)
 	}
 
+	return ok, nil
+}
+
+func (w *WebElement) IsDisplayed() bool {
+	ok, err := w.IsDisplayedE()
+	must(err)
+
 	return ok
 }
 
-func (w *WebElement) Is() *WebElement {
-	ok, err := w.WebClient.Is(w.SessionId, w.WebElementId)
+// IsE
+// waits for element to be displayed
+// opts override service wait for this call
+func (w *WebElement) IsE(opts ...WaitOption) (*WebElement, error) {
+	ok, err := w.WebClient.IsContext(w.waiting(opts...).Context(), w.SessionId, w.WebElementId)
 	if err != nil {
-		panic(fmt.Sprintf("error on is: %v", err))
+		return nil, driverError("is", w.WebElementSelector, err)
 	}
 
 	if !ok {
-		panic(fmt.Sprintf("error on is, element not displayed: %v", err))
+		return nil, driverError("is", w.WebElementSelector, ErrNotDisplayed)
 	}
 
+	return w, nil
+}
+
+func (w *WebElement) Is(opts ...WaitOption) *WebElement {
+	_, err := w.IsE(opts...)
+	must(err)
+
 	return w
 }
 
-func (w *WebElement) Click() *WebElement {
-	err := w.WebClient.Click(w.SessionId, w.WebElementId)
+// ClickE
+// clicks on element
+// opts override service wait for this call
+func (w *WebElement) ClickE(opts ...WaitOption) (*WebElement, error) {
+	err := w.WebClient.ClickContext(w.waiting(opts...).Context(), w.SessionId, w.WebElementId)
 	if err != nil {
-		panic(fmt.Sprintf("error on click: %v", err))
+		return nil, driverError("click", w.WebElementSelector, err)
 	}
 
+	return w, nil
+}
+
+func (w *WebElement) Click(opts ...WaitOption) *WebElement {
+	_, err := w.ClickE(opts...)
+	must(err)
+
 	return w
 }
 
-// Cl
+// ClE
 // finds and clicks on element
-func (w *WebDriver) Cl(s string) *WebElement {
-	selector := Strategy(s)
-	eId, err := w.WebClient.FindElement(selector, w.SessionId)
+// opts override service wait for both find and click
+func (w *WebDriver) ClE(s string, opts ...WaitOption) (*WebElement, error) {
+	el, err := w.FE(s, opts...)
 	if err != nil {
-		panic(fmt.Sprintf("error on find element: %v", err))
+		return nil, err
 	}
 
-	el := &WebElement{
-		WebDriver:    w,
-		WebElementId: eId,
-	}
+	return el.ClickE(opts...)
+}
+
+// Cl
+// finds and clicks on element
+func (w *WebDriver) Cl(s string, opts ...WaitOption) *WebElement {
+	el, err := w.ClE(s, opts...)
+	must(err)
 
-	err = w.WebClient.Click(w.SessionId, el.WebElementId)
+	return el
+}
+
+// InputE
+// inputs keys, text to a input element
+func (w *WebElement) InputE(keys string) (*WebElement, error) {
+	err := w.WebClient.InputContext(w.Context(), keys, w.SessionId, w.WebElementId)
 	if err != nil {
-		panic(fmt.Sprintf("error on click: %v", err))
+		return nil, driverError("keys", w.WebElementSelector, err)
 	}
 
-	return el
+	return w, nil
 }
 
 // Input
 // inputs keys, text to a input element
 func (w *WebElement) Input(keys string) *WebElement {
-	err := w.WebClient.Input(keys, w.SessionId, w.WebElementId)
-	if err != nil {
-		panic(fmt.Sprintf("error on keys: %v", err))
-	}
+	_, err := w.InputE(keys)
+	must(err)
 
 	return w
 }
 
-func (w *WebElement) Attr(attr string) string {
-	a, err := w.WebClient.Attr(attr, w.SessionId, w.WebElementId)
+func (w *WebElement) AttrE(attr string) (string, error) {
+	a, err := w.WebClient.AttrContext(w.Context(), attr, w.SessionId, w.WebElementId)
 	if err != nil {
-		panic(fmt.Sprintf("error on attribute: %v", err))
+		return "", driverError("attribute", w.WebElementSelector, err)
 	}
 
+	return a, nil
+}
+
+func (w *WebElement) Attr(attr string) string {
+	a, err := w.AttrE(attr)
+	must(err)
+
 	return a
 }
 
-func (w *WebDriver) ExecuteScript(s string, args ...interface{}) {
-	err := w.WebClient.Script(s, w.SessionId, args)
+// ExecuteScriptE
+// executes sync script
+// *WebElement args are sent as element references
+// returns script result, i.e. string, bool, float64, *WebElement
+func (w *WebDriver) ExecuteScriptE(s string, args ...interface{}) (interface{}, error) {
+	raw, err := w.WebClient.ScriptRawContext(w.Context(), s, w.SessionId, args...)
 	if err != nil {
-		panic(fmt.Sprintf("error on script: %v", err))
+		return nil, driverError("script", nil, err)
 	}
+
+	return w.scriptValue(raw)
 }
 
-func (w *WebDriver) Script(fName string, args ...interface{}) {
-	f, err := config.FindFile(config.Config.JsFilesPath, fmt.Sprintf("%s.js", fName))
-	if err != nil {
-		panic(fmt.Sprintf("error on find file: %v", err))
-	}
+func (w *WebDriver) ExecuteScript(s string, args ...interface{}) interface{} {
+	v, err := w.ExecuteScriptE(s, args...)
+	must(err)
 
-	c, err := os.ReadFile(f)
+	return v
+}
+
+// ExecuteAsyncE
+// executes async script
+// script resolves with callback passed as last argument
+//
+//	d.ExecuteAsync("setTimeout(arguments[0], 100, 'done')")
+func (w *WebDriver) ExecuteAsyncE(s string, args ...interface{}) (interface{}, error) {
+	raw, err := w.WebClient.AsyncScriptRawContext(w.Context(), s, w.SessionId, args...)
 	if err != nil {
-		panic(fmt.Sprintf("error on read file: %v", err))
+		return nil, driverError("async script", nil, err)
 	}
 
-	err = w.WebClient.Script(string(c), w.SessionId, args)
+	return w.scriptValue(raw)
+}
+
+func (w *WebDriver) ExecuteAsync(s string, args ...interface{}) interface{} {
+	v, err := w.ExecuteAsyncE(s, args...)
+	must(err)
+
+	return v
+}
+
+// ScriptE
+// executes sync script bundled in js package
+// or found in JsFilesPath, which overrides bundled one
+// fName is file name without .js extension
+func (w *WebDriver) ScriptE(fName string, args ...interface{}) (interface{}, error) {
+	c, err := js.Source(fName)
 	if err != nil {
-		panic(fmt.Sprintf("error on script: %v", err))
+		return nil, driverError("script source", nil, err)
 	}
+
+	return w.ExecuteScriptE(c, args...)
+}
+
+func (w *WebDriver) Script(fName string, args ...interface{}) interface{} {
+	v, err := w.ScriptE(fName, args...)
+	must(err)
+
+	return v
 }
 
 func (w *WebElement) Id() map[string]string {
@@ -374,108 +724,106 @@ func (w *WebElement) Id() map[string]string {
 	}
 }
 
-func (w *WebDriver) Screenshot() {
-	err := w.WebClient.Screenshot(w.SessionId)
+func (w *WebDriver) ScreenshotE() error {
+	err := w.WebClient.ScreenshotContext(w.Context(), w.SessionId)
 	if err != nil {
-		panic(fmt.Sprintf("error on script: %v", err))
+		return driverError("screenshot", nil, err)
 	}
+
+	return nil
 }
 
-func (w *WebDriver) Active() *WebElement {
-	eId, err := w.WebClient.Active(w.SessionId)
+func (w *WebDriver) Screenshot() {
+	must(w.ScreenshotE())
+}
+
+func (w *WebDriver) ActiveE() (*WebElement, error) {
+	eId, err := w.WebClient.ActiveContext(w.Context(), w.SessionId)
 	if err != nil {
-		panic(fmt.Sprintf("error on find element: %v", err))
+		return nil, driverError("active element", nil, err)
 	}
 
 	return &WebElement{
 		WebDriver:    w,
 		WebElementId: eId,
-	}
+	}, nil
 }
 
-// Text
+func (w *WebDriver) Active() *WebElement {
+	el, err := w.ActiveE()
+	must(err)
+
+	return el
+}
+
+// TextE
 // retrieves text from element
-func (w *WebElement) Text() string {
-	txt, err := w.WebClient.Text(w.SessionId, w.WebElementId)
+func (w *WebElement) TextE() (string, error) {
+	txt, err := w.WebClient.TextContext(w.Context(), w.SessionId, w.WebElementId)
 	if err != nil {
-		panic(fmt.Sprintf("error on action: %v", err))
+		return "", driverError("text", w.WebElementSelector, err)
 	}
 
-	return txt
+	return txt, nil
 }
 
-func (w *WebDriver) Until(fn func() bool) {
-	var success bool
-	start := time.Now()
-	end := start.Add(config.Config.WaitForTimeout * time.Second)
+// Text
+// retrieves text from element
+func (w *WebElement) Text() string {
+	txt, err := w.TextE()
+	must(err)
 
-	for {
-		success = fn()
-		if success {
-			break
-		}
+	return txt
+}
 
-		if time.Now().After(end) {
-			panic("error on until")
-		}
+// UntilE
+// polls fn until it returns true
+// opts override config WaitForTimeout, WaitForInterval
+// returns ErrWaitTimeout after timeout
+func (w *WebDriver) UntilE(fn func() bool, opts ...WaitOption) error {
+	return w.poll("until", Func("until", fn), opts...)
+}
 
-		time.Sleep(config.Config.WaitForInterval * time.Millisecond)
-	}
+func (w *WebDriver) Until(fn func() bool, opts ...WaitOption) {
+	must(w.UntilE(fn, opts...))
 }
 
-func readFile(name string) ([]byte, error) {
-	c, err := os.ReadFile(name)
+// SetValueJsE
+// Combines selenium selector strategy
+// And Find element method with JS set value
+func (w *WebDriver) SetValueJsE(selector, value string) error {
+	el, err := w.FE(selector)
 	if err != nil {
-		return nil, fmt.Errorf("error on read file: %v", err)
+		return err
 	}
 
-	return c, nil
+	_, err = el.SetValueJsE(value)
+	return err
 }
 
 // SetValueJs
 // Combines selenium selector strategy
 // And Find element method with JS set value
 func (w *WebDriver) SetValueJs(selector, value string) {
-	el := w.F(selector)
-
-	args := []interface{}{el.WebElementId, value}
-
-	f, err := config.FindFile(config.Config.JsFilesPath, "setValue.js")
-	if err != nil {
-		panic(fmt.Sprintf("error on find file: %v", err))
-	}
+	must(w.SetValueJsE(selector, value))
+}
 
-	c, err := readFile(f)
+// ClickJsE
+// Combines selenium selector strategy
+// And Find element method with JS click
+func (w *WebDriver) ClickJsE(selector string) error {
+	el, err := w.FE(selector)
 	if err != nil {
-		panic(fmt.Sprintf("error on file read in setValue.js: %v", err))
+		return err
 	}
 
-	err = w.WebClient.Script(string(c), w.SessionId, args)
-	if err != nil {
-		panic(fmt.Sprintf("error on script: %v", err))
-	}
+	_, err = el.ClickJsE()
+	return err
 }
 
 // ClickJs
 // Combines selenium selector strategy
 // And Find element method with JS click
 func (w *WebDriver) ClickJs(selector string) {
-	el := w.F(selector)
-
-	args := []interface{}{el.WebElementId}
-
-	f, err := config.FindFile(config.Config.JsFilesPath, "click.js")
-	if err != nil {
-		panic(fmt.Sprintf("error on find file: %v", err))
-	}
-
-	c, err := readFile(f)
-	if err != nil {
-		panic(fmt.Sprintf("error on file read in setValue.js: %v", err))
-	}
-
-	err = w.WebClient.Script(string(c), w.SessionId, args)
-	if err != nil {
-		panic(fmt.Sprintf("error on script: %v", err))
-	}
+	must(w.ClickJsE(selector))
 }
@@ -0,0 +1,253 @@
+package driver_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mcsymiv/gost/client"
+	"github.com/mcsymiv/gost/config"
+	"github.com/mcsymiv/gost/driver"
+	"github.com/mcsymiv/gost/fake"
+)
+
+const home = "https://fake.test"
+
+func login(srv *fake.Server) *fake.Document {
+	return srv.Page(home,
+		fake.El("form", fake.Attr("id", "login"), fake.Child(
+			fake.El("input", fake.Attr("id", "user"), fake.Attr("placeholder", "Work email")),
+			fake.El("button", fake.Attr("id", "submit"), fake.Text("LOG IN"), fake.OnClick(func(s *fake.Session, n *fake.Node) {
+				n.SetAttr("data-clicked", "true")
+			})),
+		)),
+		fake.El("div", fake.Attr("id", "hidden"), fake.Hidden()),
+	)
+}
+
+func TestFindClickInput(t *testing.T) {
+	d, srv := fake.Gost(t)
+	login(srv)
+
+	d.Open(home)
+	d.F("Work email").Input("user@fake.test")
+
+	if v := d.F("#user").Attr("value"); v != "user@fake.test" {
+		t.Errorf("unexpected input value: %q", v)
+	}
+
+	el := d.Cl("LOG IN")
+	if el.Attr("data-clicked") != "true" {
+		t.Error("expected button click")
+	}
+
+	if txt := el.Text(); txt != "LOG IN" {
+		t.Errorf("unexpected text: %q", txt)
+	}
+
+	if p := el.Parent().Attr("id"); p != "login" {
+		t.Errorf("unexpected parent: %q", p)
+	}
+
+	if n := len(d.F("#login").Nexts("//input")); n != 1 {
+		t.Errorf("expected 1 input in form, got: %d", n)
+	}
+}
+
+func TestFindError(t *testing.T) {
+	d, srv := fake.Gost(t)
+	login(srv)
+
+	d.Open(home)
+
+	_, err := d.FE("#missing")
+	if !errors.Is(err, client.ErrNoSuchElement) {
+		t.Fatalf("expected no such element, got: %v", err)
+	}
+
+	var derr *driver.DriverError
+	if !errors.As(err, &derr) || derr.Op != "find element" || derr.Selector.Value != "#missing" {
+		t.Errorf("unexpected driver error: %#v", derr)
+	}
+
+	_, err = d.F("#hidden").IsE()
+	if !errors.Is(err, driver.ErrNotDisplayed) {
+		t.Errorf("expected not displayed, got: %v", err)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected F to panic")
+		}
+	}()
+
+	d.F("#missing")
+}
+
+func TestStaleElement(t *testing.T) {
+	d, srv := fake.Gost(t)
+	doc := login(srv)
+
+	d.Open(home)
+	el := d.F("#submit")
+
+	srv.Update(func() {
+		doc.Find("#submit").Remove()
+	})
+
+	_, err := el.ClickE()
+	if !errors.Is(err, client.ErrStaleElementReference) {
+		t.Errorf("expected stale element reference, got: %v", err)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	d, srv := fake.Gost(t)
+	login(srv)
+
+	d.Open(home)
+	config.Config.WaitForTimeout = 5
+
+	wd, cancel := d.WithTimeout(200 * time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := wd.FE("#missing")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got: %v", err)
+	}
+
+	if since := time.Since(start); since > 2*time.Second {
+		t.Errorf("expected lookup abort on deadline, took: %s", since)
+	}
+
+	// parent driver is not bound to deadline
+	if _, err := d.FE("#user"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestWithContextCancel(t *testing.T) {
+	d, srv := fake.Gost(t)
+	login(srv)
+
+	d.Open(home)
+	el := d.F("#submit")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := el.WithContext(ctx).ClickE(); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected canceled click, got: %v", err)
+	}
+
+	if el.Attr("data-clicked") == "true" {
+		t.Error("expected no click on canceled context")
+	}
+}
+
+func TestWaitOptions(t *testing.T) {
+	d, srv := fake.Gost(t)
+	login(srv)
+
+	d.Open(home)
+	config.Config.WaitForTimeout = 5
+
+	start := time.Now()
+	if _, err := d.FE("#missing", driver.NoWait()); !errors.Is(err, client.ErrNoSuchElement) {
+		t.Fatalf("expected no such element, got: %v", err)
+	}
+
+	if since := time.Since(start); since > time.Second {
+		t.Errorf("expected single find attempt on NoWait, took: %s", since)
+	}
+
+	if n := srv.Count("POST /session/{id}/element"); n != 1 {
+		t.Errorf("expected 1 find request, got: %d", n)
+	}
+
+	start = time.Now()
+	_, err := d.FE("#missing", driver.Timeout(300*time.Millisecond), driver.Interval(50*time.Millisecond))
+	if err == nil {
+		t.Fatal("expected find error")
+	}
+
+	if since := time.Since(start); since < 300*time.Millisecond || since > 2*time.Second {
+		t.Errorf("expected find to wait for 300ms timeout, took: %s", since)
+	}
+
+	if n := srv.Count("POST /session/{id}/element"); n < 5 {
+		t.Errorf("expected retries on 50ms interval, got: %d find requests", n)
+	}
+
+	// wait options are not kept by found element
+	el := d.F("#submit", driver.NoWait())
+	if el.WebDriver != d {
+		t.Error("expected element bound to driver")
+	}
+}
+
+func TestNavigation(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home).Title = "Home"
+	srv.Page(home + "/next").Title = "Next"
+
+	d.Open(home)
+	d.Open(home + "/next")
+
+	if title := d.Title(); title != "Next" {
+		t.Errorf("unexpected title: %q", title)
+	}
+
+	d.Back()
+	if u := d.CurrentURL(); u != home {
+		t.Errorf("expected back to %q, got: %q", home, u)
+	}
+
+	d.Forward()
+	if u := d.CurrentURL(); u != home+"/next" {
+		t.Errorf("expected forward to %q, got: %q", home+"/next", u)
+	}
+
+	d.Refresh()
+	if err := d.WaitE(driver.TitleMatches("^Next$"), driver.NoWait()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestNoWaitScreenshot(t *testing.T) {
+	d, srv := fake.Gost(t)
+	login(srv)
+
+	d.Open(home)
+	config.Config.ScreenshotOnFail = true
+
+	d.FE("#missing", driver.NoWait())
+	d.FsE("#missing", driver.NoWait())
+	if n := srv.Count("GET /session/{id}/screenshot"); n != 0 {
+		t.Errorf("unexpected screenshot on absence check: %d", n)
+	}
+
+	d.FE("#missing", driver.Timeout(50*time.Millisecond))
+	if n := srv.Count("GET /session/{id}/screenshot"); n != 1 {
+		t.Errorf("expected screenshot on failed find, got: %d", n)
+	}
+}
+
+func TestClickWaitOptions(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home, fake.El("button", fake.Attr("id", "hidden"), fake.Hidden()))
+
+	d.Open(home)
+	config.Config.WaitForTimeout = 5
+
+	start := time.Now()
+	if _, err := d.ClE("#hidden", driver.Timeout(100*time.Millisecond)); err == nil {
+		t.Fatal("expected click error")
+	}
+
+	if since := time.Since(start); since > 2*time.Second {
+		t.Errorf("expected click to use per-call timeout, took: %s", since)
+	}
+}
@@ -0,0 +1,156 @@
+package driver
+
+import (
+	"fmt"
+
+	"github.com/mcsymiv/gost/data"
+)
+
+// PropertyE
+// returns element DOM property
+// i.e. value, checked, or nil if not defined
+func (w *WebElement) PropertyE(name string) (interface{}, error) {
+	v, err := w.WebClient.PropertyContext(w.Context(), name, w.SessionId, w.WebElementId)
+	if err != nil {
+		return nil, driverError(fmt.Sprintf("property %q", name), w.WebElementSelector, err)
+	}
+
+	return v, nil
+}
+
+func (w *WebElement) Property(name string) interface{} {
+	v, err := w.PropertyE(name)
+	must(err)
+
+	return v
+}
+
+// CSSValueE
+// returns computed css property value
+// i.e. "rgb(255, 0, 0)" for "color"
+func (w *WebElement) CSSValueE(name string) (string, error) {
+	v, err := w.WebClient.CSSValueContext(w.Context(), name, w.SessionId, w.WebElementId)
+	if err != nil {
+		return "", driverError(fmt.Sprintf("css value %q", name), w.WebElementSelector, err)
+	}
+
+	return v, nil
+}
+
+func (w *WebElement) CSSValue(name string) string {
+	v, err := w.CSSValueE(name)
+	must(err)
+
+	return v
+}
+
+// TagNameE
+// returns element tag name
+func (w *WebElement) TagNameE() (string, error) {
+	tag, err := w.WebClient.TagNameContext(w.Context(), w.SessionId, w.WebElementId)
+	if err != nil {
+		return "", driverError("tag name", w.WebElementSelector, err)
+	}
+
+	return tag, nil
+}
+
+func (w *WebElement) TagName() string {
+	tag, err := w.TagNameE()
+	must(err)
+
+	return tag
+}
+
+// RectE
+// returns element position and size
+// relative to top-left corner of the document
+func (w *WebElement) RectE() (*data.Rect, error) {
+	rect, err := w.WebClient.ElementRectContext(w.Context(), w.SessionId, w.WebElementId)
+	if err != nil {
+		return nil, driverError("element rect", w.WebElementSelector, err)
+	}
+
+	return rect, nil
+}
+
+func (w *WebElement) Rect() *data.Rect {
+	rect, err := w.RectE()
+	must(err)
+
+	return rect
+}
+
+// IsEnabledE
+// element is not disabled
+func (w *WebElement) IsEnabledE() (bool, error) {
+	ok, err := w.WebClient.IsEnabledContext(w.Context(), w.SessionId, w.WebElementId)
+	if err != nil {
+		return false, driverError("enabled", w.WebElementSelector, err)
+	}
+
+	return ok, nil
+}
+
+func (w *WebElement) IsEnabled() bool {
+	ok, err := w.IsEnabledE()
+	must(err)
+
+	return ok
+}
+
+// IsSelectedE
+// checkbox, radio is checked
+// or option is selected
+func (w *WebElement) IsSelectedE() (bool, error) {
+	ok, err := w.WebClient.IsSelectedContext(w.Context(), w.SessionId, w.WebElementId)
+	if err != nil {
+		return false, driverError("selected", w.WebElementSelector, err)
+	}
+
+	return ok, nil
+}
+
+func (w *WebElement) IsSelected() bool {
+	ok, err := w.IsSelectedE()
+	must(err)
+
+	return ok
+}
+
+// ComputedRoleE
+// returns element accessibility role
+// i.e. "button", "link", "textbox"
+func (w *WebElement) ComputedRoleE() (string, error) {
+	role, err := w.WebClient.ComputedRoleContext(w.Context(), w.SessionId, w.WebElementId)
+	if err != nil {
+		return "", driverError("computed role", w.WebElementSelector, err)
+	}
+
+	return role, nil
+}
+
+func (w *WebElement) ComputedRole() string {
+	role, err := w.ComputedRoleE()
+	must(err)
+
+	return role
+}
+
+// ComputedLabelE
+// returns element accessible name
+func (w *WebElement) ComputedLabelE() (string, error) {
+	label, err := w.WebClient.ComputedLabelContext(w.Context(), w.SessionId, w.WebElementId)
+	if err != nil {
+		return "", driverError("computed label", w.WebElementSelector, err)
+	}
+
+	return label, nil
+}
+
+func (w *WebElement) ComputedLabel() string {
+	label, err := w.ComputedLabelE()
+	must(err)
+
+	return label
+}
@@ -0,0 +1,59 @@
+package driver_test
+
+import (
+	"testing"
+
+	"github.com/mcsymiv/gost/data"
+	"github.com/mcsymiv/gost/fake"
+)
+
+func TestElementState(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home,
+		fake.El("input", fake.Attr("id", "email"), fake.Attr("value", "user@fake.test"), fake.Attr("placeholder", "Work email")),
+		fake.El("input", fake.Attr("id", "terms"), fake.Attr("type", "checkbox"), fake.Attr("checked", "")),
+		fake.El("button", fake.Attr("id", "save"), fake.Attr("disabled", ""), fake.Text("Save"),
+			fake.Style("color", "rgb(255, 0, 0)"), fake.Rect(10, 20, 100, 40)),
+	)
+
+	d.Open(home)
+
+	email := d.F("#email")
+	if v := email.Property("value"); v != "user@fake.test" {
+		t.Errorf("unexpected value property: %v", v)
+	}
+
+	if v := email.Property("missing"); v != nil {
+		t.Errorf("expected nil undefined property, got: %v", v)
+	}
+
+	if role, label := email.ComputedRole(), email.ComputedLabel(); role != "textbox" || label != "Work email" {
+		t.Errorf("unexpected email role and label: %q, %q", role, label)
+	}
+
+	terms := d.F("#terms")
+	if !terms.IsSelected() || terms.Property("checked") != true {
+		t.Error("expected terms checkbox to be checked")
+	}
+
+	save := d.F("#save")
+	if save.IsEnabled() {
+		t.Error("expected save button to be disabled")
+	}
+
+	if tag := save.TagName(); tag != "button" {
+		t.Errorf("unexpected tag name: %q", tag)
+	}
+
+	if c := save.CSSValue("color"); c != "rgb(255, 0, 0)" {
+		t.Errorf("unexpected css value: %q", c)
+	}
+
+	if r := save.Rect(); *r != (data.Rect{X: 10, Y: 20, Width: 100, Height: 40}) {
+		t.Errorf("unexpected rect: %+v", r)
+	}
+
+	if role, label := save.ComputedRole(), save.ComputedLabel(); role != "button" || label != "Save" {
+		t.Errorf("unexpected save role and label: %q, %q", role, label)
+	}
+}
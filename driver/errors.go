@@ -0,0 +1,66 @@
+package driver
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mcsymiv/gost/data"
+)
+
+var (
+	// ErrNotDisplayed
+	// returned by IsE when element is found
+	// but not displayed within the timeout
+	ErrNotDisplayed = errors.New("element not displayed")
+
+	// ErrWaitTimeout
+	// returned by UntilE when condition
+	// is not satisfied within the timeout
+	ErrWaitTimeout = errors.New("wait timeout")
+
+	// ErrNoSuchOption
+	// returned by Select methods
+	// when select has no matching option
+	ErrNoSuchOption = errors.New("no such option")
+)
+
+// DriverError
+// typed error returned by error-returning
+// WebDriver and WebElement methods, i.e. FE, ClickE
+// Op holds failed operation name, i.e. "find element"
+// Selector is set if operation used one
+// Err holds underlying client error
+type DriverError struct {
+	Op       string
+	Selector *data.Selector
+	Err      error
+}
+
+func (e *DriverError) Error() string {
+	if e.Selector != nil {
+		return fmt.Sprintf("error on %s, %s %q: %v", e.Op, e.Selector.Using, e.Selector.Value, e.Err)
+	}
+
+	return fmt.Sprintf("error on %s: %v", e.Op, e.Err)
+}
+
+func (e *DriverError) Unwrap() error {
+	return e.Err
+}
+
+func driverError(op string, selector *data.Selector, err error) error {
+	return &DriverError{
+		Op:       op,
+		Selector: selector,
+		Err:      err,
+	}
+}
+
+// must
+// panics with error
+// used by panicking wrappers around E methods
+func must(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,234 @@
+package driver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ClearE
+// clears input, textarea or contenteditable element
+// driver fires change event
+func (w *WebElement) ClearE() (*WebElement, error) {
+	err := w.WebClient.ClearContext(w.Context(), w.SessionId, w.WebElementId)
+	if err != nil {
+		return nil, driverError("clear", w.WebElementSelector, err)
+	}
+
+	return w, nil
+}
+
+func (w *WebElement) Clear() *WebElement {
+	el, err := w.ClearE()
+	must(err)
+
+	return el
+}
+
+// SetTextE
+// clears element and types text
+// unlike SetValueJs, app key and input handlers are fired
+func (w *WebElement) SetTextE(text string) (*WebElement, error) {
+	if _, err := w.ClearE(); err != nil {
+		return nil, err
+	}
+
+	return w.InputE(text)
+}
+
+func (w *WebElement) SetText(text string) *WebElement {
+	el, err := w.SetTextE(text)
+	must(err)
+
+	return el
+}
+
+// options
+// option elements of select element
+func (w *WebElement) options() ([]*WebElement, error) {
+	tag, err := w.TagNameE()
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(tag, "select") {
+		return nil, driverError("select", w.WebElementSelector, fmt.Errorf("element <%s> is not select", tag))
+	}
+
+	return w.NextsE("//option", NoWait())
+}
+
+// choose
+// clicks option if it is not selected yet
+// click on selected option of multiple select deselects it
+func (w *WebElement) choose(o *WebElement) (*WebElement, error) {
+	selected, err := o.IsSelectedE()
+	if err != nil {
+		return nil, err
+	}
+
+	if !selected {
+		if _, err := o.ClickE(); err != nil {
+			return nil, err
+		}
+	}
+
+	return w, nil
+}
+
+// SelectByTextE
+// selects option of select element by visible text
+// whitespace is normalized
+func (w *WebElement) SelectByTextE(text string) (*WebElement, error) {
+	opts, err := w.options()
+	if err != nil {
+		return nil, err
+	}
+
+	want := strings.Join(strings.Fields(text), " ")
+	for _, o := range opts {
+		t, err := o.TextE()
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.Join(strings.Fields(t), " ") == want {
+			return w.choose(o)
+		}
+	}
+
+	return nil, driverError(fmt.Sprintf("select text %q", text), w.WebElementSelector, ErrNoSuchOption)
+}
+
+func (w *WebElement) SelectByText(text string) *WebElement {
+	el, err := w.SelectByTextE(text)
+	must(err)
+
+	return el
+}
+
+// SelectByValueE
+// selects option of select element by value attribute
+func (w *WebElement) SelectByValueE(value string) (*WebElement, error) {
+	opts, err := w.options()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, o := range opts {
+		v, err := o.AttrE("value")
+		if err != nil {
+			return nil, err
+		}
+
+		if v == value {
+			return w.choose(o)
+		}
+	}
+
+	return nil, driverError(fmt.Sprintf("select value %q", value), w.WebElementSelector, ErrNoSuchOption)
+}
+
+func (w *WebElement) SelectByValue(value string) *WebElement {
+	el, err := w.SelectByValueE(value)
+	must(err)
+
+	return el
+}
+
+// SelectByIndexE
+// selects option of select element by zero-based index
+func (w *WebElement) SelectByIndexE(i int) (*WebElement, error) {
+	opts, err := w.options()
+	if err != nil {
+		return nil, err
+	}
+
+	if i < 0 || i >= len(opts) {
+		return nil, driverError(fmt.Sprintf("select index %d", i), w.WebElementSelector, ErrNoSuchOption)
+	}
+
+	return w.choose(opts[i])
+}
+
+func (w *WebElement) SelectByIndex(i int) *WebElement {
+	el, err := w.SelectByIndexE(i)
+	must(err)
+
+	return el
+}
+
+// CheckE
+// checks checkbox or radio
+// does nothing if already checked
+func (w *WebElement) CheckE() (*WebElement, error) {
+	checked, err := w.IsSelectedE()
+	if err != nil {
+		return nil, err
+	}
+
+	if checked {
+		return w, nil
+	}
+
+	return w.ClickE()
+}
+
+func (w *WebElement) Check() *WebElement {
+	el, err := w.CheckE()
+	must(err)
+
+	return el
+}
+
+// UncheckE
+// unchecks checkbox
+// does nothing if not checked
+// checked radio can't be unchecked by click
+// check other radio of the group instead
+func (w *WebElement) UncheckE() (*WebElement, error) {
+	checked, err := w.IsSelectedE()
+	if err != nil {
+		return nil, err
+	}
+
+	if !checked {
+		return w, nil
+	}
+
+	typ, err := w.AttrE("type")
+	if err != nil {
+		return nil, err
+	}
+
+	if typ == "radio" {
+		return nil, driverError("uncheck", w.WebElementSelector, fmt.Errorf("checked radio can't be unchecked"))
+	}
+
+	return w.ClickE()
+}
+
+func (w *WebElement) Uncheck() *WebElement {
+	el, err := w.UncheckE()
+	must(err)
+
+	return el
+}
+
+// SubmitE
+// submits form element or the form containing element
+// with requestSubmit, so validation and submit handlers run
+func (w *WebElement) SubmitE() (*WebElement, error) {
+	_, err := w.ScriptE("submit", w)
+	if err != nil {
+		return nil, driverError("submit", w.WebElementSelector, err)
+	}
+
+	return w, nil
+}
+
+func (w *WebElement) Submit() *WebElement {
+	el, err := w.SubmitE()
+	must(err)
+
+	return el
+}
@@ -0,0 +1,92 @@
+package driver_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mcsymiv/gost/driver"
+	"github.com/mcsymiv/gost/fake"
+)
+
+func TestForm(t *testing.T) {
+	d, srv := fake.Gost(t)
+	doc := srv.Page(home,
+		fake.El("form", fake.Attr("id", "signup"),
+			fake.Child(
+				fake.El("input", fake.Attr("id", "email"), fake.Attr("value", "old@fake.test")),
+				fake.El("select", fake.Attr("id", "plan"), fake.Child(
+					fake.El("option", fake.Attr("value", "free"), fake.Attr("selected", ""), fake.Text("Free")),
+					fake.El("option", fake.Attr("value", "pro"), fake.Text(" Pro  plan ")),
+					fake.El("option", fake.Attr("value", "team"), fake.Text("Team")),
+				)),
+				fake.El("input", fake.Attr("id", "terms"), fake.Attr("type", "checkbox")),
+				fake.El("input", fake.Attr("id", "monthly"), fake.Attr("type", "radio"), fake.Attr("name", "billing"), fake.Attr("checked", "")),
+				fake.El("input", fake.Attr("id", "yearly"), fake.Attr("type", "radio"), fake.Attr("name", "billing")),
+				fake.El("button", fake.Attr("id", "save"), fake.Text("Save")),
+			),
+		),
+	)
+
+	var submitted []interface{}
+	srv.HandleScript("function submit", func(s *fake.Session, script string, args []interface{}) (interface{}, error) {
+		submitted = args
+		return nil, nil
+	})
+
+	d.Open(home)
+
+	d.F("#email").SetText("user@fake.test")
+	if v := doc.Find("#email").Attrs["value"]; v != "user@fake.test" {
+		t.Errorf("unexpected email value: %q", v)
+	}
+
+	plan := d.F("#plan")
+	plan.SelectByText("Pro plan")
+	if !d.F("//option[@value='pro']").IsSelected() || d.F("//option[@value='free']").IsSelected() {
+		t.Error("expected Pro plan option to be selected")
+	}
+
+	plan.SelectByValue("team").SelectByIndex(0)
+	if _, ok := doc.Find("option").Attr("selected"); !ok {
+		t.Error("expected first option to be selected")
+	}
+
+	if _, err := plan.SelectByValueE("enterprise"); !errors.Is(err, driver.ErrNoSuchOption) {
+		t.Errorf("expected no such option, got: %v", err)
+	}
+
+	if _, err := d.F("#email").SelectByIndexE(0); err == nil || !strings.Contains(err.Error(), "not select") {
+		t.Errorf("expected not select error, got: %v", err)
+	}
+
+	// idempotent
+	clicks := srv.Count("POST /session/{id}/element/{id}/click")
+	terms := d.F("#terms").Check().Check()
+	if !terms.IsSelected() || srv.Count("POST /session/{id}/element/{id}/click") != clicks+1 {
+		t.Error("expected terms to be checked with single click")
+	}
+
+	terms.Uncheck().Uncheck()
+	if terms.IsSelected() {
+		t.Error("expected terms to be unchecked")
+	}
+
+	d.F("#yearly").Check()
+	if d.F("#monthly").IsSelected() {
+		t.Error("expected monthly radio to be unchecked by yearly")
+	}
+
+	if _, err := d.F("#yearly").UncheckE(); err == nil {
+		t.Error("expected error on radio uncheck")
+	}
+
+	d.F("#save").Submit()
+	if len(submitted) != 1 || submitted[0] != doc.Find("#save") {
+		t.Errorf("expected submit script with save button, got: %v", submitted)
+	}
+
+	if _, err := d.F("#save").ClearE(); err == nil {
+		t.Error("expected error on button clear")
+	}
+}
@@ -0,0 +1,105 @@
+package driver
+
+import (
+	"context"
+	"fmt"
+)
+
+// SwitchToFrameE
+// switches to iframe of current browsing context
+// frame is index int, *WebElement or selector string
+//
+//	d.SwitchToFrame(0)
+//	d.SwitchToFrame(d.F("#checkout"))
+//	d.SwitchToFrame("#checkout")
+func (w *WebDriver) SwitchToFrameE(frame interface{}) error {
+	var id interface{}
+
+	switch f := frame.(type) {
+	case int:
+		id = f
+	case *WebElement:
+		id = f.Id()
+	case string:
+		el, err := w.FE(f)
+		if err != nil {
+			return err
+		}
+
+		id = el.Id()
+	default:
+		return driverError("switch frame", nil, fmt.Errorf("unsupported frame type %T", frame))
+	}
+
+	err := w.WebClient.SwitchFrameContext(w.Context(), id, w.SessionId)
+	if err != nil {
+		return driverError(fmt.Sprintf("switch frame %v", frame), nil, err)
+	}
+
+	return nil
+}
+
+func (w *WebDriver) SwitchToFrame(frame interface{}) {
+	must(w.SwitchToFrameE(frame))
+}
+
+// SwitchToParentFrameE
+// leaves current iframe
+func (w *WebDriver) SwitchToParentFrameE() error {
+	err := w.WebClient.ParentFrameContext(w.Context(), w.SessionId)
+	if err != nil {
+		return driverError("switch parent frame", nil, err)
+	}
+
+	return nil
+}
+
+func (w *WebDriver) SwitchToParentFrame() {
+	must(w.SwitchToParentFrameE())
+}
+
+// SwitchToDefaultContentE
+// switches to top-level document
+// from any nested iframe
+func (w *WebDriver) SwitchToDefaultContentE() error {
+	err := w.WebClient.SwitchFrameContext(w.Context(), nil, w.SessionId)
+	if err != nil {
+		return driverError("switch default content", nil, err)
+	}
+
+	return nil
+}
+
+func (w *WebDriver) SwitchToDefaultContent() {
+	must(w.SwitchToDefaultContentE())
+}
+
+// InFrameE
+// runs fn inside iframe
+// switches back to parent frame after fn returns or panics
+// fn must leave frame it was given, nested InFrame calls do
+// parent frame is restored even if driver context is cancelled
+//
+//	d.InFrame("#checkout", func(f *driver.WebDriver) {
+//		f.F("#card").Input("4242 4242 4242 4242")
+//	})
+func (w *WebDriver) InFrameE(frame interface{}, fn func(f *WebDriver)) (err error) {
+	if err := w.SwitchToFrameE(frame); err != nil {
+		return err
+	}
+
+	defer func() {
+		restore := w.WithContext(context.WithoutCancel(w.Context()))
+		if perr := restore.SwitchToParentFrameE(); err == nil {
+			err = perr
+		}
+	}()
+
+	fn(w)
+
+	return nil
+}
+
+func (w *WebDriver) InFrame(frame interface{}, fn func(f *WebDriver)) {
+	must(w.InFrameE(frame, fn))
+}
@@ -0,0 +1,87 @@
+package driver_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mcsymiv/gost/client"
+	"github.com/mcsymiv/gost/driver"
+	"github.com/mcsymiv/gost/fake"
+)
+
+func TestFrames(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home,
+		fake.El("input", fake.Attr("id", "email")),
+		fake.El("iframe", fake.Attr("id", "checkout"), fake.Frame(
+			fake.El("input", fake.Attr("id", "card")),
+			fake.El("iframe", fake.Attr("id", "verify"), fake.Frame(
+				fake.El("button", fake.Attr("id", "confirm"), fake.Text("Confirm")),
+			)),
+		)),
+	)
+
+	d.Open(home)
+
+	if _, err := d.FE("#card", driver.NoWait()); !errors.Is(err, client.ErrNoSuchElement) {
+		t.Errorf("expected frame element not found from top document, got: %v", err)
+	}
+
+	d.InFrame("#checkout", func(f *driver.WebDriver) {
+		f.F("#card").Input("4242")
+
+		f.InFrame(0, func(f *driver.WebDriver) {
+			f.Cl("Confirm")
+		})
+
+		if v := f.F("#card").Attr("value"); v != "4242" {
+			t.Errorf("expected parent frame restored, got card value: %q", v)
+		}
+	})
+
+	d.F("#email")
+
+	d.SwitchToFrame(d.F("#checkout"))
+	d.SwitchToFrame("#verify")
+	d.SwitchToDefaultContent()
+	d.F("#email")
+
+	if err := d.SwitchToFrameE(3); !errors.Is(err, client.ErrNoSuchFrame) {
+		t.Errorf("expected no such frame, got: %v", err)
+	}
+
+	func() {
+		defer func() { recover() }()
+
+		d.InFrame("#checkout", func(f *driver.WebDriver) {
+			panic("step failed")
+		})
+	}()
+
+	if _, err := d.FE("#email", driver.NoWait()); err != nil {
+		t.Errorf("expected top document after panic in frame: %v", err)
+	}
+}
+
+func TestInFrameRestoresOnCancel(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home,
+		fake.El("input", fake.Attr("id", "email")),
+		fake.El("iframe", fake.Attr("id", "checkout"), fake.Frame(
+			fake.El("input", fake.Attr("id", "card")),
+		)),
+	)
+
+	d.Open(home)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	d.WithContext(ctx).InFrame("#checkout", func(f *driver.WebDriver) {
+		f.F("#card")
+		cancel()
+	})
+
+	if _, err := d.FE("#email", driver.NoWait()); err != nil {
+		t.Errorf("expected top document after cancel in frame: %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package driver
+
+// ClickJsE
+// clicks element with HTMLElement.click
+// bypasses overlapping elements and pointer checks
+func (w *WebElement) ClickJsE() (*WebElement, error) {
+	_, err := w.ScriptE("click", w)
+	if err != nil {
+		return nil, driverError("click js", w.WebElementSelector, err)
+	}
+
+	return w, nil
+}
+
+func (w *WebElement) ClickJs() *WebElement {
+	el, err := w.ClickJsE()
+	must(err)
+
+	return el
+}
+
+// SetValueJsE
+// sets input value
+// and dispatches input and change events
+func (w *WebElement) SetValueJsE(value string) (*WebElement, error) {
+	_, err := w.ScriptE("setValue", w, value)
+	if err != nil {
+		return nil, driverError("set value js", w.WebElementSelector, err)
+	}
+
+	return w, nil
+}
+
+func (w *WebElement) SetValueJs(value string) *WebElement {
+	el, err := w.SetValueJsE(value)
+	must(err)
+
+	return el
+}
+
+// HighlightE
+// outlines element, i.e. before screenshot
+// color is css color, red if empty
+func (w *WebElement) HighlightE(color string) (*WebElement, error) {
+	_, err := w.ScriptE("highlight", w, color)
+	if err != nil {
+		return nil, driverError("highlight", w.WebElementSelector, err)
+	}
+
+	return w, nil
+}
+
+func (w *WebElement) Highlight(color string) *WebElement {
+	el, err := w.HighlightE(color)
+	must(err)
+
+	return el
+}
+
+// ComputedStyleE
+// returns resolved css property value
+// i.e. "rgb(255, 0, 0)" for "color"
+func (w *WebElement) ComputedStyleE(property string) (string, error) {
+	v, err := w.ScriptE("computedStyle", w, property)
+	if err != nil {
+		return "", driverError("computed style", w.WebElementSelector, err)
+	}
+
+	style, _ := v.(string)
+	return style, nil
+}
+
+func (w *WebElement) ComputedStyle(property string) string {
+	style, err := w.ComputedStyleE(property)
+	must(err)
+
+	return style
+}
+
+// ReloadJsE
+// reloads page with location.reload
+func (w *WebDriver) ReloadJsE() error {
+	_, err := w.ScriptE("reload")
+	if err != nil {
+		return driverError("reload js", nil, err)
+	}
+
+	return nil
+}
+
+func (w *WebDriver) ReloadJs() {
+	must(w.ReloadJsE())
+}
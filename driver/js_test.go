@@ -0,0 +1,48 @@
+package driver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mcsymiv/gost/fake"
+)
+
+func TestJsHelpers(t *testing.T) {
+	d, srv := fake.Gost(t)
+	doc := srv.Page(home,
+		fake.El("input", fake.Attr("id", "email")),
+	)
+
+	var scripts []string
+	srv.HandleScript("", func(s *fake.Session, script string, args []interface{}) (interface{}, error) {
+		scripts = append(scripts, script)
+
+		switch {
+		case strings.Contains(script, "function setValue"):
+			args[0].(*fake.Node).SetAttr("value", args[1].(string))
+		case strings.Contains(script, "function computedStyle"):
+			return "rgb(255, 0, 0)", nil
+		}
+
+		return nil, nil
+	})
+
+	d.Open(home)
+
+	el := d.F("#email").SetValueJs("user@fake.test").Highlight("")
+	if v := doc.Find("#email").Attrs["value"]; v != "user@fake.test" {
+		t.Errorf("unexpected js value: %q", v)
+	}
+
+	if c := el.ComputedStyle("color"); c != "rgb(255, 0, 0)" {
+		t.Errorf("unexpected computed style: %q", c)
+	}
+
+	d.ReloadJs()
+
+	for i, want := range []string{"input", "function highlight", "function computedStyle", "location.reload"} {
+		if i >= len(scripts) || !strings.Contains(scripts[i], want) {
+			t.Errorf("expected script %d to contain %q, got: %v", i, want, scripts)
+		}
+	}
+}
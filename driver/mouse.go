@@ -0,0 +1,141 @@
+package driver
+
+import "time"
+
+// dragDuration
+// pointer move duration of drag gestures
+// some apps ignore instant moves
+const dragDuration = 250 * time.Millisecond
+
+// gesture
+// performs actions as element operation
+func (w *WebElement) gesture(op string, a *Actions) (*WebElement, error) {
+	if err := a.PerformE(); err != nil {
+		return nil, driverError(op, w.WebElementSelector, err)
+	}
+
+	return w, nil
+}
+
+// HoverE
+// moves mouse to element center
+// i.e. to open hover menu
+func (w *WebElement) HoverE() (*WebElement, error) {
+	return w.gesture("hover", w.Actions().MoveTo(w, 0, 0))
+}
+
+func (w *WebElement) Hover() *WebElement {
+	el, err := w.HoverE()
+	must(err)
+
+	return el
+}
+
+// DoubleClickE
+// double clicks on element center
+func (w *WebElement) DoubleClickE() (*WebElement, error) {
+	return w.gesture("double click", w.Actions().
+		MoveTo(w, 0, 0).
+		PointerDown(LeftButton).
+		PointerUp(LeftButton).
+		PointerDown(LeftButton).
+		PointerUp(LeftButton))
+}
+
+func (w *WebElement) DoubleClick() *WebElement {
+	el, err := w.DoubleClickE()
+	must(err)
+
+	return el
+}
+
+// RightClickE
+// right clicks on element center
+// i.e. to open context menu
+func (w *WebElement) RightClickE() (*WebElement, error) {
+	return w.gesture("right click", w.Actions().
+		MoveTo(w, 0, 0).
+		PointerDown(RightButton).
+		PointerUp(RightButton))
+}
+
+func (w *WebElement) RightClick() *WebElement {
+	el, err := w.RightClickE()
+	must(err)
+
+	return el
+}
+
+// ClickAtE
+// clicks at offset from element center
+// i.e. on canvas point
+func (w *WebElement) ClickAtE(offsetX, offsetY int) (*WebElement, error) {
+	return w.gesture("click at", w.Actions().
+		MoveTo(w, offsetX, offsetY).
+		PointerDown(LeftButton).
+		PointerUp(LeftButton))
+}
+
+func (w *WebElement) ClickAt(offsetX, offsetY int) *WebElement {
+	el, err := w.ClickAtE(offsetX, offsetY)
+	must(err)
+
+	return el
+}
+
+// DragToE
+// drags element with mouse and drops it on target center
+func (w *WebElement) DragToE(target *WebElement) (*WebElement, error) {
+	return w.gesture("drag to", w.Actions().
+		MoveTo(w, 0, 0).
+		PointerDown(LeftButton).
+		Duration(dragDuration).
+		MoveTo(target, 0, 0).
+		PointerUp(LeftButton))
+}
+
+func (w *WebElement) DragTo(target *WebElement) *WebElement {
+	el, err := w.DragToE(target)
+	must(err)
+
+	return el
+}
+
+// DragByE
+// drags element with mouse by offset
+// i.e. slider handle
+func (w *WebElement) DragByE(dx, dy int) (*WebElement, error) {
+	return w.gesture("drag by", w.Actions().
+		MoveTo(w, 0, 0).
+		PointerDown(LeftButton).
+		Duration(dragDuration).
+		MoveBy(dx, dy).
+		PointerUp(LeftButton))
+}
+
+func (w *WebElement) DragBy(dx, dy int) *WebElement {
+	el, err := w.DragByE(dx, dy)
+	must(err)
+
+	return el
+}
+
+// DragToJsE
+// HTML5 drag and drop fallback
+// dispatches dragstart, dragenter, dragover, drop and dragend
+// for apps where native pointer drag does not fire drag events
+func (w *WebElement) DragToJsE(target *WebElement) (*WebElement, error) {
+	_, err := w.ScriptE("dragAndDrop", w, target)
+	if err != nil {
+		return nil, driverError("drag to js", w.WebElementSelector, err)
+	}
+
+	return w, nil
+}
+
+func (w *WebElement) DragToJs(target *WebElement) *WebElement {
+	el, err := w.DragToJsE(target)
+	must(err)
+
+	return el
+}
@@ -0,0 +1,92 @@
+package driver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mcsymiv/gost/fake"
+)
+
+func TestMouse(t *testing.T) {
+	d, srv := fake.Gost(t)
+
+	var events []string
+	on := func(event string) fake.NodeOption {
+		return fake.On(event, func(s *fake.Session, n *fake.Node) {
+			events = append(events, n.Attrs["id"]+":"+event)
+		})
+	}
+
+	srv.Page(home,
+		fake.El("li", fake.Attr("id", "menu"), fake.Rect(0, 0, 100, 20), on("mouseover")),
+		fake.El("li", fake.Attr("id", "row"), fake.Rect(0, 40, 100, 20), on("dblclick"), on("contextmenu"), on("click")),
+		fake.El("div", fake.Attr("id", "card"), fake.Rect(0, 100, 50, 50), on("mousedown")),
+		fake.El("div", fake.Attr("id", "done"), fake.Rect(200, 100, 50, 50), on("mouseup")),
+	)
+
+	d.Open(home)
+
+	d.F("#menu").Hover()
+	d.F("#row").DoubleClick().RightClick()
+	d.F("#card").DragTo(d.F("#done"))
+	d.F("#card").DragBy(200, 0)
+
+	want := []string{
+		"menu:mouseover",
+		"row:click", "row:click", "row:dblclick",
+		"row:contextmenu",
+		"card:mousedown", "done:mouseup",
+		"card:mousedown", "done:mouseup",
+	}
+
+	if strings.Join(events, ",") != strings.Join(want, ",") {
+		t.Errorf("unexpected events:\n%v\nwant:\n%v", events, want)
+	}
+
+	if n := srv.Count("DELETE /session/{id}/actions"); n != 5 {
+		t.Errorf("expected actions released after each gesture, got: %d", n)
+	}
+}
+
+func TestMouseClickAt(t *testing.T) {
+	d, srv := fake.Gost(t)
+
+	var clicked []string
+	click := func(s *fake.Session, n *fake.Node) { clicked = append(clicked, n.Attrs["id"]) }
+
+	srv.Page(home,
+		fake.El("div", fake.Attr("id", "canvas"), fake.Rect(0, 0, 200, 200), fake.OnClick(click),
+			fake.Child(fake.El("span", fake.Attr("id", "marker"), fake.Rect(150, 150, 20, 20), fake.OnClick(click))),
+		),
+	)
+
+	d.Open(home)
+
+	// element at offset point is clicked
+	d.F("#canvas").ClickAt(-50, -50).ClickAt(60, 60)
+	if strings.Join(clicked, ",") != "canvas,marker" {
+		t.Errorf("unexpected clicked elements: %v", clicked)
+	}
+}
+
+func TestDragToJs(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home,
+		fake.El("div", fake.Attr("id", "card")),
+		fake.El("div", fake.Attr("id", "done")),
+	)
+
+	var args []interface{}
+	srv.HandleScript("function dragAndDrop", func(s *fake.Session, script string, a []interface{}) (interface{}, error) {
+		args = a
+		return nil, nil
+	})
+
+	d.Open(home)
+	d.F("#card").DragToJs(d.F("#done"))
+
+	doc := srv.Session(d.SessionId).Document()
+	if len(args) != 2 || args[0] != doc.Find("#card") || args[1] != doc.Find("#done") {
+		t.Errorf("unexpected drag and drop args: %v", args)
+	}
+}
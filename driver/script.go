@@ -0,0 +1,195 @@
+package driver
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/mcsymiv/gost/config"
+)
+
+// MarshalJSON
+// sends element as W3C element reference
+// i.e. script argument
+func (w *WebElement) MarshalJSON() ([]byte, error) {
+	return json.Marshal(w.Id())
+}
+
+// UnmarshalJSON
+// reads W3C element reference
+// element is bound to driver by script Into methods
+func (w *WebElement) UnmarshalJSON(b []byte) error {
+	ref := map[string]interface{}{}
+	if err := json.Unmarshal(b, &ref); err != nil {
+		return err
+	}
+
+	id, ok := ref[config.WebElementIdentifier].(string)
+	if !ok {
+		return fmt.Errorf("error on element reference: %s", b)
+	}
+
+	w.WebElementId = id
+	return nil
+}
+
+// MarshalJSON
+// sends shadow root as W3C shadow root reference
+func (r *ShadowRoot) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]string{config.ShadowRootIdentifier: r.ShadowRootId})
+}
+
+// ExecuteScriptIntoE
+// executes sync script
+// decodes script result into v, as json.Unmarshal
+// element references are decoded into *WebElement fields
+//
+//	var res struct {
+//		Title string      `json:"title"`
+//		Save  *WebElement `json:"save"`
+//	}
+//	d.ExecuteScriptInto(&res, "return {title: document.title, save: document.querySelector('#save')}")
+func (w *WebDriver) ExecuteScriptIntoE(v interface{}, s string, args ...interface{}) error {
+	raw, err := w.WebClient.ScriptRawContext(w.Context(), s, w.SessionId, args...)
+	if err != nil {
+		return driverError("script", nil, err)
+	}
+
+	return w.scriptInto(raw, v)
+}
+
+func (w *WebDriver) ExecuteScriptInto(v interface{}, s string, args ...interface{}) {
+	must(w.ExecuteScriptIntoE(v, s, args...))
+}
+
+// ExecuteAsyncIntoE
+// executes async script
+// decodes script result into v
+func (w *WebDriver) ExecuteAsyncIntoE(v interface{}, s string, args ...interface{}) error {
+	raw, err := w.WebClient.AsyncScriptRawContext(w.Context(), s, w.SessionId, args...)
+	if err != nil {
+		return driverError("async script", nil, err)
+	}
+
+	return w.scriptInto(raw, v)
+}
+
+func (w *WebDriver) ExecuteAsyncInto(v interface{}, s string, args ...interface{}) {
+	must(w.ExecuteAsyncIntoE(v, s, args...))
+}
+
+// scriptValue
+// decodes script result
+// element references are converted to *WebElement
+func (w *WebDriver) scriptValue(raw json.RawMessage) (interface{}, error) {
+	var v interface{}
+	if len(raw) == 0 {
+		return v, nil
+	}
+
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, driverError("script result", nil, err)
+	}
+
+	return w.elements(v), nil
+}
+
+// scriptInto
+// decodes script result into v
+// and binds decoded elements to driver
+func (w *WebDriver) scriptInto(raw json.RawMessage, v interface{}) error {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return driverError("script result", nil, err)
+	}
+
+	w.bind(reflect.ValueOf(v), map[uintptr]bool{})
+	return nil
+}
+
+// elements
+// converts element references
+// in decoded script result
+func (w *WebDriver) elements(v interface{}) interface{} {
+	switch t := v.(type) {
+	case []interface{}:
+		for i := range t {
+			t[i] = w.elements(t[i])
+		}
+	case map[string]interface{}:
+		if id, ok := t[config.WebElementIdentifier].(string); ok && len(t) == 1 {
+			return &WebElement{
+				WebDriver:    w,
+				WebElementId: id,
+			}
+		}
+
+		for k := range t {
+			t[k] = w.elements(t[k])
+		}
+	}
+
+	return v
+}
+
+var webElementType = reflect.TypeOf(&WebElement{})
+
+// bind
+// sets driver of *WebElement values
+// decoded into caller struct, slice or map
+func (w *WebDriver) bind(v reflect.Value, seen map[uintptr]bool) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() || seen[v.Pointer()] {
+			return
+		}
+
+		seen[v.Pointer()] = true
+
+		if v.Type() == webElementType {
+			if el := v.Interface().(*WebElement); el.WebDriver == nil {
+				el.WebDriver = w
+			}
+
+			return
+		}
+
+		w.bind(v.Elem(), seen)
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+
+		// decoded maps and slices
+		// may hold element references
+		if v.CanSet() {
+			v.Set(reflect.ValueOf(w.elements(v.Interface())))
+			return
+		}
+
+		w.bind(v.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				w.bind(v.Field(i), seen)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			w.bind(v.Index(i), seen)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if e := iter.Value(); e.Kind() == reflect.Interface && !e.IsNil() {
+				v.SetMapIndex(iter.Key(), reflect.ValueOf(w.elements(e.Interface())))
+				continue
+			}
+
+			w.bind(iter.Value(), seen)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package driver_test
+
+import (
+	"testing"
+
+	"github.com/mcsymiv/gost/driver"
+	"github.com/mcsymiv/gost/fake"
+)
+
+func TestScriptResults(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home,
+		fake.El("button", fake.Attr("id", "save"), fake.Text("Save")),
+		fake.El("button", fake.Attr("id", "cancel"), fake.Text("Cancel")),
+	)
+
+	srv.HandleScript("buttons", func(s *fake.Session, script string, args []interface{}) (interface{}, error) {
+		return map[string]interface{}{
+			"title":   "Fake",
+			"count":   2,
+			"buttons": []*fake.Node{s.Document().Find("#save"), s.Document().Find("#cancel")},
+		}, nil
+	})
+
+	var clicked *fake.Node
+	srv.HandleScript("click", func(s *fake.Session, script string, args []interface{}) (interface{}, error) {
+		clicked, _ = args[0].(*fake.Node)
+		return args[0], nil
+	})
+
+	srv.HandleScript("arguments[0]", func(s *fake.Session, script string, args []interface{}) (interface{}, error) {
+		return args[0], nil
+	})
+
+	d.Open(home)
+
+	v := d.ExecuteScript("return buttons()").(map[string]interface{})
+	els, ok := v["buttons"].([]interface{})
+	if !ok || len(els) != 2 {
+		t.Fatalf("unexpected buttons result: %#v", v["buttons"])
+	}
+
+	if txt := els[1].(*driver.WebElement).Text(); txt != "Cancel" {
+		t.Errorf("expected element result bound to driver, got text: %q", txt)
+	}
+
+	var res struct {
+		Title   string               `json:"title"`
+		Count   int                  `json:"count"`
+		Buttons []*driver.WebElement `json:"buttons"`
+	}
+	d.ExecuteScriptInto(&res, "return buttons()")
+
+	if res.Title != "Fake" || res.Count != 2 || len(res.Buttons) != 2 {
+		t.Fatalf("unexpected decoded result: %+v", res)
+	}
+
+	if txt := res.Buttons[0].Text(); txt != "Save" {
+		t.Errorf("expected decoded element bound to driver, got text: %q", txt)
+	}
+
+	save := d.F("#save")
+	if el, ok := d.ExecuteAsync("arguments[1](arguments[0])", save).(*driver.WebElement); !ok || el.WebElementId != save.WebElementId {
+		t.Errorf("expected async element argument round trip, got: %#v", el)
+	}
+
+	d.ClickJs("#cancel")
+	if clicked == nil || clicked.Text != "Cancel" {
+		t.Errorf("expected js click on cancel, got: %+v", clicked)
+	}
+
+	if n := srv.Count("POST /session/{id}/execute/async"); n != 1 {
+		t.Errorf("expected async script request, got: %d", n)
+	}
+}
@@ -0,0 +1,207 @@
+package driver
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mcsymiv/gost/client"
+)
+
+// Block
+// vertical alignment of element scrolled into view
+type Block string
+
+const (
+	BlockStart   Block = "start"
+	BlockCenter  Block = "center"
+	BlockEnd     Block = "end"
+	BlockNearest Block = "nearest"
+)
+
+// scrollStep
+// page scroll delta of ScrollUntil
+// container is scrolled by its height
+const scrollStep = 400
+
+// wheelUnsupported
+// driver without wheel input source
+// i.e. older chromedriver and geckodriver
+func wheelUnsupported(err error) bool {
+	return errors.Is(err, client.ErrInvalidArgument) ||
+		errors.Is(err, client.ErrUnsupportedOperation) ||
+		errors.Is(err, client.ErrUnknownCommand)
+}
+
+// scroll
+// scrolls page, or container if el is set,
+// with wheel actions, or with script if driver has no wheel
+func (w *WebDriver) scroll(el *WebElement, dx, dy int) error {
+	a := w.Actions()
+	if el != nil {
+		a.ScrollFrom(el, 0, 0, dx, dy)
+	} else {
+		a.ScrollBy(dx, dy)
+	}
+
+	err := a.PerformE()
+	if err == nil || !wheelUnsupported(err) {
+		return err
+	}
+
+	var target interface{}
+	if el != nil {
+		target = el
+	}
+
+	_, err = w.ScriptE("scrollBy", target, dx, dy)
+	return err
+}
+
+// ScrollIntoViewE
+// scrolls element into view
+// aligned vertically by block, BlockCenter if empty
+func (w *WebElement) ScrollIntoViewE(block Block) (*WebElement, error) {
+	if block == "" {
+		block = BlockCenter
+	}
+
+	_, err := w.ScriptE("scrollIntoView", w, block)
+	if err != nil {
+		return nil, driverError("scroll into view", w.WebElementSelector, err)
+	}
+
+	return w, nil
+}
+
+func (w *WebElement) ScrollIntoView(block Block) *WebElement {
+	el, err := w.ScrollIntoViewE(block)
+	must(err)
+
+	return el
+}
+
+// ScrollByE
+// scrolls page by delta in pixels
+func (w *WebDriver) ScrollByE(dx, dy int) error {
+	if err := w.scroll(nil, dx, dy); err != nil {
+		return driverError("scroll by", nil, err)
+	}
+
+	return nil
+}
+
+func (w *WebDriver) ScrollBy(dx, dy int) {
+	must(w.ScrollByE(dx, dy))
+}
+
+// ScrollToE
+// scrolls page to position in pixels
+// by delta from current scroll position
+func (w *WebDriver) ScrollToE(x, y int) error {
+	v, err := w.ScriptE("scrollPosition", nil)
+	if err != nil {
+		return driverError("scroll to", nil, err)
+	}
+
+	pos, ok := v.([]interface{})
+	if !ok || len(pos) != 2 {
+		return driverError("scroll to", nil, fmt.Errorf("unexpected scroll position: %v", v))
+	}
+
+	px, _ := pos[0].(float64)
+	py, _ := pos[1].(float64)
+
+	if err := w.scroll(nil, x-int(px), y-int(py)); err != nil {
+		return driverError("scroll to", nil, err)
+	}
+
+	return nil
+}
+
+func (w *WebDriver) ScrollTo(x, y int) {
+	must(w.ScrollToE(x, y))
+}
+
+// scrollUntil
+// scrolls page or container
+// until selector is found, up to maxScrolls times
+// found element is awaited for WaitForInterval after each scroll
+func (w *WebDriver) scrollUntil(container *WebElement, selector string, maxScrolls int) (*WebElement, error) {
+	el, err := w.probe(selector, NoWait())
+	if el != nil || err != nil {
+		return el, err
+	}
+
+	step := scrollStep
+	if container != nil {
+		if r, err := container.RectE(); err == nil && r.Height > 0 {
+			step = int(r.Height)
+		}
+	}
+
+	wait := Timeout(w.WebClient.WebConfig.WaitForInterval * time.Millisecond)
+
+	for i := 0; i < maxScrolls; i++ {
+		if err := w.scroll(container, 0, step); err != nil {
+			return nil, err
+		}
+
+		el, err = w.probe(selector, wait)
+		if el != nil || err != nil {
+			return el, err
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s, after %d scrolls", client.ErrNoSuchElement, selector, maxScrolls)
+}
+
+// probe
+// returns first element found by selector, nil if none
+// miss is not an error, so failure screenshot is not taken
+func (w *WebDriver) probe(selector string, opts ...WaitOption) (*WebElement, error) {
+	els, err := w.FsE(selector, opts...)
+	if err != nil || len(els) == 0 {
+		return nil, err
+	}
+
+	return els[0], nil
+}
+
+// ScrollUntilE
+// scrolls page until selector is found
+// i.e. item of lazy loaded list
+func (w *WebDriver) ScrollUntilE(selector string, maxScrolls int) (*WebElement, error) {
+	el, err := w.scrollUntil(nil, selector, maxScrolls)
+	if err != nil {
+		return nil, driverError("scroll until", Strategy(selector), err)
+	}
+
+	return el, nil
+}
+
+func (w *WebDriver) ScrollUntil(selector string, maxScrolls int) *WebElement {
+	el, err := w.ScrollUntilE(selector, maxScrolls)
+	must(err)
+
+	return el
+}
+
+// ScrollUntilE
+// scrolls container element by its height
+// until selector is found
+func (w *WebElement) ScrollUntilE(selector string, maxScrolls int) (*WebElement, error) {
+	el, err := w.scrollUntil(w, selector, maxScrolls)
+	if err != nil {
+		return nil, driverError("scroll until", Strategy(selector), err)
+	}
+
+	return el, nil
+}
+
+func (w *WebElement) ScrollUntil(selector string, maxScrolls int) *WebElement {
+	el, err := w.ScrollUntilE(selector, maxScrolls)
+	must(err)
+
+	return el
+}
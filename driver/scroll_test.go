@@ -0,0 +1,108 @@
+package driver_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mcsymiv/gost/client"
+	"github.com/mcsymiv/gost/config"
+	"github.com/mcsymiv/gost/driver"
+	"github.com/mcsymiv/gost/fake"
+)
+
+// feed
+// lazy list loading 10 items on each scroll
+func feed() *fake.Node {
+	return fake.El("ul", fake.Attr("id", "feed"), fake.Rect(0, 0, 300, 600),
+		fake.On("scroll", func(s *fake.Session, n *fake.Node) {
+			for i := 0; i < 10; i++ {
+				id := fmt.Sprintf("item-%d", len(n.Children)+1)
+				n.Append(fake.El("li", fake.Attr("id", id), fake.Text(id)))
+			}
+		}),
+	)
+}
+
+func TestScrollUntil(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home, feed())
+
+	d.Open(home)
+
+	el := d.F("#feed").ScrollUntil("#item-25", 5)
+	if el.Text() != "item-25" {
+		t.Errorf("unexpected element: %q", el.Text())
+	}
+
+	if n := srv.Count("POST /session/{id}/actions"); n != 3 {
+		t.Errorf("expected 3 scrolls, got: %d", n)
+	}
+
+	_, err := d.F("#feed").ScrollUntilE("#item-100", 2)
+	if !errors.Is(err, client.ErrNoSuchElement) || !strings.Contains(err.Error(), "after 2 scrolls") {
+		t.Errorf("expected no such element after 2 scrolls, got: %v", err)
+	}
+
+	// page wheel scroll at viewport origin hits feed
+	if _, err := d.ScrollUntilE("#item-31", 1); err != nil {
+		t.Errorf("unexpected page scroll error: %v", err)
+	}
+}
+
+func TestScrollUntilProbe(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home, feed())
+
+	d.Open(home)
+	config.Config.ScreenshotOnFail = true
+
+	d.F("#feed").ScrollUntilE("#item-100", 3)
+	if n := srv.Count("GET /session/{id}/screenshot"); n != 0 {
+		t.Errorf("unexpected screenshots on scroll probes: %d", n)
+	}
+
+	scrolls := srv.Count("POST /session/{id}/actions")
+
+	_, err := d.F("#feed").ScrollUntilE("//li[", 3)
+	if !errors.Is(err, client.ErrInvalidSelector) {
+		t.Errorf("expected invalid selector, got: %v", err)
+	}
+
+	if n := srv.Count("POST /session/{id}/actions"); n != scrolls {
+		t.Errorf("expected no scroll on invalid selector, got: %d", n-scrolls)
+	}
+}
+
+func TestScrollJsFallback(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.NoWheel = true
+	srv.Page(home, fake.El("div", fake.Attr("id", "content")))
+
+	var scripts []string
+	srv.HandleScript("", func(s *fake.Session, script string, args []interface{}) (interface{}, error) {
+		switch {
+		case strings.Contains(script, "function scrollPosition"):
+			scripts = append(scripts, "position")
+			return []int{0, 100}, nil
+		case strings.Contains(script, "function scrollBy"):
+			scripts = append(scripts, fmt.Sprintf("by %v %v %v", args[0], args[1], args[2]))
+		case strings.Contains(script, "function scrollIntoView"):
+			scripts = append(scripts, fmt.Sprintf("into view %v", args[1]))
+		}
+
+		return nil, nil
+	})
+
+	d.Open(home)
+
+	d.ScrollBy(0, 300)
+	d.ScrollTo(0, 500)
+	d.F("#content").ScrollIntoView(driver.BlockStart).ScrollIntoView("")
+
+	want := []string{"by <nil> 0 300", "position", "by <nil> 0 400", "into view start", "into view center"}
+	if strings.Join(scripts, ",") != strings.Join(want, ",") {
+		t.Errorf("unexpected scripts:\n%v\nwant:\n%v", scripts, want)
+	}
+}
@@ -36,6 +36,11 @@ import (
 // css:
 // for simplicity, it check for opening bracket [
 //
+// pierce:
+// shadow host and inner css selectors separated by >>>
+// i.e. "my-app >>> settings-panel >>> #save"
+// checked after xpath, see isPierce
+//
 // text:
 // as final option, if selector does not contain /, [ symbols
 // XPathTextStrategy will be used
@@ -43,11 +48,16 @@ func Strategy(value string) *data.Selector {
 	var s *data.Selector = &data.Selector{}
 	s.Value = value
 
-	if value[0] == '/' || value[1] == '/' {
+	if value[0] == '/' || value[0] == '(' || value[1] == '/' {
 		s.Using = data.ByXPath
 		return s
 	}
 
+	if isPierce(value) {
+		s.Using = data.ByPierce
+		return s
+	}
+
 	// if ok, m := checkSubstrings(value, ".", "#", "[", "]"); ok || m > 0 {
 	if value[0] == '[' || value[0] == '#' {
 		s.Using = data.ByCssSelector
@@ -69,6 +79,20 @@ func Strategy(value string) *data.Selector {
 	return s
 }
 
+// isPierce
+// css host and inner selectors separated by >>>
+// host is single css selector, i.e. custom element "my-app", "#id", ".class" or "[attr]"
+// so text, i.e. "Next >>> Last", is not pierced
+func isPierce(value string) bool {
+	parts, err := pierceParts(value)
+	if err != nil || len(parts) < 2 {
+		return false
+	}
+
+	host := parts[0]
+	return !strings.ContainsAny(host, " \t\n") && strings.ContainsAny(host, "-#.[")
+}
+
 func NextStrategy(value string) *data.Selector {
 	var s *data.Selector = &data.Selector{}
 
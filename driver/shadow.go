@@ -0,0 +1,204 @@
+package driver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mcsymiv/gost/data"
+)
+
+// ShadowRoot
+// open shadow root of host element
+// found elements belong to host driver
+type ShadowRoot struct {
+	*WebDriver
+	ShadowRootId string
+	Host         *WebElement
+}
+
+// ShadowRootE
+// returns shadow root of host element
+func (w *WebElement) ShadowRootE() (*ShadowRoot, error) {
+	id, err := w.WebClient.ShadowRootContext(w.Context(), w.SessionId, w.WebElementId)
+	if err != nil {
+		return nil, driverError("shadow root", w.WebElementSelector, err)
+	}
+
+	return &ShadowRoot{
+		WebDriver:    w.WebDriver,
+		ShadowRootId: id,
+		Host:         w,
+	}, nil
+}
+
+func (w *WebElement) ShadowRoot() *ShadowRoot {
+	root, err := w.ShadowRootE()
+	must(err)
+
+	return root
+}
+
+// FindElementE
+// finds element in shadow root
+// drivers support css, tag name and link text strategies only
+func (r *ShadowRoot) FindElementE(selector *data.Selector) (*WebElement, error) {
+	eId, err := r.WebClient.FromShadowElementContext(r.Context(), selector, r.SessionId, r.ShadowRootId)
+	if err != nil {
+		return nil, driverError("find element", selector, err)
+	}
+
+	return &WebElement{
+		WebDriver:          r.WebDriver,
+		WebElementId:       eId,
+		WebElementSelector: selector,
+	}, nil
+}
+
+func (r *ShadowRoot) FindElementsE(selector *data.Selector) ([]*WebElement, error) {
+	elementsId, err := r.WebClient.FromShadowElementsContext(r.Context(), selector, r.SessionId, r.ShadowRootId)
+	if err != nil {
+		return nil, driverError("find elements", selector, err)
+	}
+
+	var els []*WebElement
+
+	for _, id := range elementsId {
+		els = append(els, &WebElement{
+			WebDriver:          r.WebDriver,
+			WebElementId:       id,
+			WebElementSelector: selector,
+		})
+	}
+
+	return els, nil
+}
+
+// FE
+// finds element by css selector in shadow root
+func (r *ShadowRoot) FE(s string) (*WebElement, error) {
+	return r.FindElementE(Css(s))
+}
+
+func (r *ShadowRoot) F(s string) *WebElement {
+	el, err := r.FE(s)
+	must(err)
+
+	return el
+}
+
+// FsE
+// finds elements by css selector in shadow root
+func (r *ShadowRoot) FsE(s string) ([]*WebElement, error) {
+	return r.FindElementsE(Css(s))
+}
+
+func (r *ShadowRoot) Fs(s string) []*WebElement {
+	els, err := r.FsE(s)
+	must(err)
+
+	return els
+}
+
+// pierceParts
+// splits "host >>> inner" selector
+func pierceParts(value string) ([]string, error) {
+	var parts []string
+
+	for _, p := range strings.Split(value, data.PierceSeparator) {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			return nil, fmt.Errorf("empty part in pierce selector %q", value)
+		}
+
+		parts = append(parts, p)
+	}
+
+	return parts, nil
+}
+
+// pierceHost
+// host selector is xpath if it starts with /
+// otherwise css
+func pierceHost(value string) *data.Selector {
+	if value[0] == '/' {
+		return &data.Selector{Using: data.ByXPath, Value: value}
+	}
+
+	return Css(value)
+}
+
+// pierce
+// walks shadow roots of "host >>> inner" selector parts
+// returns shadow root containing the last part
+func (w *WebDriver) pierce(selector *data.Selector) (*ShadowRoot, string, error) {
+	parts, err := pierceParts(selector.Value)
+	if err == nil && len(parts) < 2 {
+		err = fmt.Errorf("pierce selector %q has no %s separated inner selector", selector.Value, data.PierceSeparator)
+	}
+
+	if err != nil {
+		return nil, "", driverError("find element", selector, err)
+	}
+
+	host, err := w.FindElementE(pierceHost(parts[0]))
+	if err != nil {
+		return nil, "", err
+	}
+
+	for _, p := range parts[1 : len(parts)-1] {
+		root, err := host.ShadowRootE()
+		if err != nil {
+			return nil, "", err
+		}
+
+		host, err = root.FE(p)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	root, err := host.ShadowRootE()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return root, parts[len(parts)-1], nil
+}
+
+// pierceElement
+// finds element by "host >>> inner" selector
+func (w *WebDriver) pierceElement(selector *data.Selector) (*WebElement, error) {
+	root, inner, err := w.pierce(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	el, err := root.FE(inner)
+	if err != nil {
+		return nil, driverError("find element", selector, err)
+	}
+
+	el.WebElementSelector = selector
+
+	return el, nil
+}
+
+// pierceElements
+// finds elements by "host >>> inner" selector
+func (w *WebDriver) pierceElements(selector *data.Selector) ([]*WebElement, error) {
+	root, inner, err := w.pierce(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	els, err := root.FsE(inner)
+	if err != nil {
+		return nil, driverError("find elements", selector, err)
+	}
+
+	for _, el := range els {
+		el.WebElementSelector = selector
+	}
+
+	return els, nil
+}
@@ -0,0 +1,83 @@
+package driver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mcsymiv/gost/client"
+	"github.com/mcsymiv/gost/data"
+	"github.com/mcsymiv/gost/driver"
+	"github.com/mcsymiv/gost/fake"
+)
+
+func TestShadowRoot(t *testing.T) {
+	d, srv := fake.Gost(t)
+
+	clicks := 0
+	srv.Page(home,
+		fake.El("div", fake.Attr("id", "plain")),
+		fake.El("my-app", fake.Shadow(
+			fake.El("settings-panel", fake.Shadow(
+				fake.El("button", fake.Attr("id", "save"), fake.Text("Save"), fake.OnClick(func(s *fake.Session, n *fake.Node) {
+					clicks++
+				})),
+				fake.El("button", fake.Attr("id", "cancel"), fake.Text("Cancel")),
+			)),
+		)),
+	)
+
+	d.Open(home)
+
+	if _, err := d.FE("#save", driver.NoWait()); !errors.Is(err, client.ErrNoSuchElement) {
+		t.Errorf("expected shadow element not found from document, got: %v", err)
+	}
+
+	panel := d.F("//my-app").ShadowRoot().F("settings-panel")
+	if n := len(panel.ShadowRoot().Fs("button")); n != 2 {
+		t.Errorf("expected 2 buttons in shadow root, got: %d", n)
+	}
+
+	d.F("my-app >>> settings-panel >>> #save").Click()
+	if clicks != 1 {
+		t.Errorf("expected click on pierced element, got: %d", clicks)
+	}
+
+	if n := len(d.Fs("my-app >>> settings-panel >>> button")); n != 2 {
+		t.Errorf("expected 2 pierced elements, got: %d", n)
+	}
+
+	if _, err := d.F("#plain").ShadowRootE(); !errors.Is(err, client.ErrNoSuchShadowRoot) {
+		t.Errorf("expected no such shadow root, got: %v", err)
+	}
+
+	if _, err := d.FE("my-app >>> #missing", driver.NoWait()); !errors.Is(err, client.ErrNoSuchElement) {
+		t.Errorf("expected no such element in shadow root, got: %v", err)
+	}
+}
+
+func TestPierceSelector(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home, fake.El("my-app", fake.Shadow(fake.El("button", fake.Attr("id", "save")))))
+
+	d.Open(home)
+
+	_, err := d.FindElementE(&data.Selector{Using: data.ByPierce, Value: "my-app"})
+	var derr *driver.DriverError
+	if !errors.As(err, &derr) {
+		t.Errorf("expected driver error on single part pierce selector, got: %v", err)
+	}
+
+	for value, using := range map[string]string{
+		"my-app >>> #save":        data.ByPierce,
+		"#root >>> button":        data.ByPierce,
+		"//div[text()='a >>> b']": data.ByXPath,
+		"(//a[@title='>>>'])[1]":  data.ByXPath,
+		"./span[.='>>>']":         data.ByXPath,
+		"Next >>> Last":           data.ByXPath,
+		"Show more >>> details":   data.ByXPath,
+	} {
+		if s := driver.Strategy(value); s.Using != using {
+			t.Errorf("expected %q to use %s, got: %s", value, using, s.Using)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package driver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrUnsupportedPointer
+// returned by actions with pointer type
+// which session browser can't emulate
+var ErrUnsupportedPointer = errors.New("unsupported pointer type")
+
+// pointerSupport
+// pointer types emulated by browser drivers
+// browsers not listed are not checked
+var pointerSupport = map[string][]PointerType{
+	"chrome":        {MousePointer, PenPointer, TouchPointer},
+	"msedge":        {MousePointer, PenPointer, TouchPointer},
+	"microsoftedge": {MousePointer, PenPointer, TouchPointer},
+	"firefox":       {MousePointer, TouchPointer},
+	"safari":        {MousePointer},
+}
+
+// Direction
+// of swipe finger move
+type Direction string
+
+const (
+	SwipeUp    Direction = "up"
+	SwipeDown  Direction = "down"
+	SwipeLeft  Direction = "left"
+	SwipeRight Direction = "right"
+)
+
+// swipeDuration
+// finger move duration of swipe and pinch
+const swipeDuration = 300 * time.Millisecond
+
+// BrowserName
+// session browserName returned by driver
+// requested browser if driver did not return one
+func (w *WebDriver) BrowserName() string {
+	if b, ok := w.SessionCapabilities["browserName"].(string); ok && b != "" {
+		return strings.ToLower(b)
+	}
+
+	if w.Capabilities != nil {
+		return strings.ToLower(w.Capabilities.Capabilities.BrowserName)
+	}
+
+	return ""
+}
+
+// supports
+// checks pointer type against session browser
+func (w *WebDriver) supports(pointerType PointerType) error {
+	types, ok := pointerSupport[w.BrowserName()]
+	if !ok {
+		return nil
+	}
+
+	for _, t := range types {
+		if t == pointerType {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s pointer is not supported by %s", ErrUnsupportedPointer, pointerType, w.BrowserName())
+}
+
+// Fingers
+// adds touch pointers "finger1".."fingerN" actions in single tick
+// fn is called for each finger with its index
+//
+//	a.Fingers(3, func(a *Actions, i int) { a.MoveTo(el, i*20, 0) })
+func (a *Actions) Fingers(n int, fn func(a *Actions, i int)) *Actions {
+	return a.Tick(func(a *Actions) {
+		for i := 0; i < n; i++ {
+			a.Pointer(fmt.Sprintf("finger%d", i+1), TouchPointer)
+			fn(a, i)
+		}
+	})
+}
+
+// TapE
+// taps element center with one finger
+func (w *WebElement) TapE() (*WebElement, error) {
+	return w.gesture("tap", w.Actions().
+		Pointer("finger1", TouchPointer).
+		MoveTo(w, 0, 0).
+		PointerDown(LeftButton).
+		PointerUp(LeftButton))
+}
+
+func (w *WebElement) Tap() *WebElement {
+	el, err := w.TapE()
+	must(err)
+
+	return el
+}
+
+// LongPressE
+// touches element center for duration
+// i.e. to open touch context menu
+func (w *WebElement) LongPressE(d time.Duration) (*WebElement, error) {
+	return w.gesture("long press", w.Actions().
+		Pointer("finger1", TouchPointer).
+		MoveTo(w, 0, 0).
+		PointerDown(LeftButton).
+		Pause(d).
+		PointerUp(LeftButton))
+}
+
+func (w *WebElement) LongPress(d time.Duration) *WebElement {
+	el, err := w.LongPressE(d)
+	must(err)
+
+	return el
+}
+
+// SwipeE
+// moves finger from element center
+// by distance in direction
+// SwipeUp scrolls content down
+func (w *WebElement) SwipeE(direction Direction, distance int) (*WebElement, error) {
+	dx, dy := 0, 0
+
+	switch direction {
+	case SwipeUp:
+		dy = -distance
+	case SwipeDown:
+		dy = distance
+	case SwipeLeft:
+		dx = -distance
+	case SwipeRight:
+		dx = distance
+	default:
+		return nil, driverError("swipe", w.WebElementSelector, fmt.Errorf("unknown direction %q", direction))
+	}
+
+	return w.gesture("swipe", w.Actions().
+		Pointer("finger1", TouchPointer).
+		MoveTo(w, 0, 0).
+		PointerDown(LeftButton).
+		Duration(swipeDuration).
+		MoveBy(dx, dy).
+		PointerUp(LeftButton))
+}
+
+func (w *WebElement) Swipe(direction Direction, distance int) *WebElement {
+	el, err := w.SwipeE(direction, distance)
+	must(err)
+
+	return el
+}
+
+// PinchE
+// moves two fingers horizontally from element center
+// positive distance spreads fingers apart (zoom in),
+// negative brings them together (zoom out)
+func (w *WebElement) PinchE(distance int) (*WebElement, error) {
+	// fingers start close for spread
+	// and far enough to meet for pinch
+	start := 10
+	if distance < 0 {
+		start -= distance / 2
+	}
+
+	side := func(i int) int {
+		if i == 0 {
+			return -1
+		}
+
+		return 1
+	}
+
+	return w.gesture("pinch", w.Actions().
+		Fingers(2, func(a *Actions, i int) { a.MoveTo(w, side(i)*start, 0) }).
+		Fingers(2, func(a *Actions, i int) { a.PointerDown(LeftButton) }).
+		Duration(swipeDuration).
+		Fingers(2, func(a *Actions, i int) { a.MoveBy(side(i)*distance/2, 0) }).
+		Fingers(2, func(a *Actions, i int) { a.PointerUp(LeftButton) }))
+}
+
+func (w *WebElement) Pinch(distance int) *WebElement {
+	el, err := w.PinchE(distance)
+	must(err)
+
+	return el
+}
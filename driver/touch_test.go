@@ -0,0 +1,93 @@
+package driver_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mcsymiv/gost/capabilities"
+	"github.com/mcsymiv/gost/driver"
+	"github.com/mcsymiv/gost/fake"
+)
+
+func TestTouch(t *testing.T) {
+	d, srv := fake.Gost(t, capabilities.BrowserName("chrome"))
+
+	taps := 0
+	srv.Page(home,
+		fake.El("button", fake.Attr("id", "menu"), fake.OnClick(func(s *fake.Session, n *fake.Node) { taps++ })),
+		fake.El("div", fake.Attr("id", "map"), fake.Rect(0, 0, 300, 300)),
+	)
+
+	d.Open(home)
+
+	d.F("#menu").Tap().LongPress(800 * time.Millisecond)
+	if taps != 2 {
+		t.Errorf("expected tap and long press clicks, got: %d", taps)
+	}
+
+	m := d.F("#map").Swipe(driver.SwipeUp, 200).Pinch(-100)
+
+	s := srv.Session(d.SessionId)
+	if len(s.Actions) != 4 {
+		t.Fatalf("expected 4 gestures, got: %d", len(s.Actions))
+	}
+
+	// long press pause tick
+	press := s.Actions[1][0]
+	if pause := press["actions"].([]interface{})[2].(map[string]interface{}); pause["duration"] != float64(800) {
+		t.Errorf("unexpected long press pause: %v", pause)
+	}
+
+	swipe := s.Actions[2][0]["actions"].([]interface{})
+	if move := swipe[2].(map[string]interface{}); move["y"] != float64(-200) || move["origin"] != "pointer" {
+		t.Errorf("unexpected swipe move: %v", move)
+	}
+
+	// two fingers move together
+	pinch := s.Actions[3]
+	if len(pinch) != 2 {
+		t.Fatalf("expected two finger sources, got: %v", pinch)
+	}
+
+	for i, want := range []float64{50, -50} {
+		src := pinch[i]
+		params := src["parameters"].(map[string]interface{})
+		move := src["actions"].([]interface{})[2].(map[string]interface{})
+		if params["pointerType"] != "touch" || move["x"] != want {
+			t.Errorf("unexpected finger %d: %v, %v", i+1, params, move)
+		}
+	}
+
+	if _, err := m.SwipeE("diagonal", 10); err == nil {
+		t.Error("expected unknown direction error")
+	}
+}
+
+func TestTouchUnsupported(t *testing.T) {
+	d, srv := fake.Gost(t, capabilities.BrowserName("safari"))
+	srv.Page(home, fake.El("button", fake.Attr("id", "menu")))
+
+	d.Open(home)
+
+	if _, err := d.F("#menu").TapE(); !errors.Is(err, driver.ErrUnsupportedPointer) {
+		t.Errorf("expected unsupported pointer on safari, got: %v", err)
+	}
+
+	if n := srv.Count("POST /session/{id}/actions"); n != 0 {
+		t.Errorf("expected no actions sent, got: %d", n)
+	}
+}
+
+func TestPenFirefox(t *testing.T) {
+	d, _ := fake.Gost(t)
+
+	err := d.Actions().Pointer("pen", driver.PenPointer).MoveToPoint(10, 10).PerformE()
+	if !errors.Is(err, driver.ErrUnsupportedPointer) {
+		t.Errorf("expected unsupported pen on firefox, got: %v", err)
+	}
+
+	if err := d.Actions().Pointer("finger1", driver.TouchPointer).MoveToPoint(10, 10).PerformE(); err != nil {
+		t.Errorf("unexpected touch error on firefox: %v", err)
+	}
+}
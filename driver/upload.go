@@ -0,0 +1,52 @@
+package driver
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// UploadE
+// sets local files to <input type=file>
+// relative paths are resolved from working directory
+// files are uploaded to remote driver host first,
+// if WebDriverAddr is not on localhost
+func (w *WebElement) UploadE(paths ...string) (*WebElement, error) {
+	if len(paths) == 0 {
+		return nil, driverError("upload", w.WebElementSelector, fmt.Errorf("no files"))
+	}
+
+	remote := w.WebClient.RemoteDriver()
+
+	files := make([]string, 0, len(paths))
+	for _, p := range paths {
+		abs, err := filepath.Abs(p)
+		if err != nil {
+			return nil, driverError("upload", w.WebElementSelector, err)
+		}
+
+		if _, err := os.Stat(abs); err != nil {
+			return nil, driverError("upload", w.WebElementSelector, err)
+		}
+
+		if remote {
+			abs, err = w.WebClient.UploadFileContext(w.Context(), abs, w.SessionId)
+			if err != nil {
+				return nil, driverError("upload", w.WebElementSelector, err)
+			}
+		}
+
+		files = append(files, abs)
+	}
+
+	// multiple files are sent as newline separated paths
+	return w.InputE(strings.Join(files, "\n"))
+}
+
+func (w *WebElement) Upload(paths ...string) *WebElement {
+	el, err := w.UploadE(paths...)
+	must(err)
+
+	return el
+}
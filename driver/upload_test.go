@@ -0,0 +1,60 @@
+package driver_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mcsymiv/gost/fake"
+)
+
+func TestUpload(t *testing.T) {
+	d, srv := fake.Gost(t)
+	doc := srv.Page(home,
+		fake.El("input", fake.Attr("id", "avatar"), fake.Attr("type", "file")),
+		fake.El("input", fake.Attr("id", "docs"), fake.Attr("type", "file"), fake.Attr("multiple", "")),
+	)
+
+	dir := t.TempDir()
+	for _, name := range []string{"a.txt", "b.txt"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	d.Open(home)
+
+	// local driver reads files from paths
+	d.F("#avatar").Upload(filepath.Join(dir, "a.txt"))
+	if v := doc.Find("#avatar").Attrs["value"]; v != filepath.Join(dir, "a.txt") {
+		t.Errorf("unexpected local file path: %q", v)
+	}
+
+	if _, err := d.F("#avatar").UploadE(filepath.Join(dir, "missing.txt")); err == nil {
+		t.Error("expected error on missing file")
+	}
+
+	// remote driver gets zipped files
+	conf := *d.WebClient.WebConfig
+	conf.WebDriverAddr = "http://grid.test:4444"
+	d.WebClient.WebConfig = &conf
+
+	uploads := srv.Count("POST /session/{id}/se/file")
+	d.F("#docs").Upload(filepath.Join(dir, "a.txt"), filepath.Join(dir, "b.txt"))
+
+	if n := srv.Count("POST /session/{id}/se/file") - uploads; n != 2 {
+		t.Errorf("expected 2 file uploads, got: %d", n)
+	}
+
+	paths := strings.Split(doc.Find("#docs").Attrs["value"], "\n")
+	if len(paths) != 2 {
+		t.Fatalf("unexpected remote file paths: %v", paths)
+	}
+
+	for i, name := range []string{"a.txt", "b.txt"} {
+		if b, ok := srv.File(paths[i]); !ok || string(b) != name || filepath.Base(paths[i]) != name {
+			t.Errorf("unexpected remote file %q: %q", paths[i], b)
+		}
+	}
+}
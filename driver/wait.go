@@ -0,0 +1,57 @@
+package driver
+
+import (
+	"context"
+	"time"
+
+	"github.com/mcsymiv/gost/client"
+)
+
+// WaitOption
+// overrides service wait policy
+// for single find, is displayed call
+//
+//	d.F("Save", driver.Timeout(5*time.Second), driver.Interval(100*time.Millisecond))
+//	d.Fs("Error", driver.NoWait())
+type WaitOption func(context.Context) context.Context
+
+// Timeout
+// service retries call until timeout
+// instead of config WaitForTimeout
+func Timeout(timeout time.Duration) WaitOption {
+	return func(ctx context.Context) context.Context {
+		return client.WithWaitTimeout(ctx, timeout)
+	}
+}
+
+// Interval
+// pause between service retries
+// instead of config WaitForInterval
+func Interval(interval time.Duration) WaitOption {
+	return func(ctx context.Context) context.Context {
+		return client.WithWaitInterval(ctx, interval)
+	}
+}
+
+// NoWait
+// service makes single attempt
+// i.e. to check element is not present
+func NoWait() WaitOption {
+	return Timeout(0)
+}
+
+// waiting
+// returns driver copy with wait options
+// driver itself if no options are passed
+func (w *WebDriver) waiting(opts ...WaitOption) *WebDriver {
+	if len(opts) == 0 {
+		return w
+	}
+
+	ctx := w.Context()
+	for _, opt := range opts {
+		ctx = opt(ctx)
+	}
+
+	return w.WithContext(ctx)
+}
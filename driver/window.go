@@ -0,0 +1,267 @@
+package driver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mcsymiv/gost/client"
+	"github.com/mcsymiv/gost/data"
+)
+
+// NewWindowE
+// opens new tab or window, returns its handle
+// typ is "tab" or "window"
+// current window is not switched
+//
+//	h := d.NewWindow("window")
+//	d.SwitchWindow(h)
+func (w *WebDriver) NewWindowE(typ string) (string, error) {
+	win, err := w.WebClient.NewWindowContext(w.Context(), typ, w.SessionId)
+	if err != nil {
+		return "", driverError("new window", nil, err)
+	}
+
+	return win.Handle, nil
+}
+
+func (w *WebDriver) NewWindow(typ string) string {
+	handle, err := w.NewWindowE(typ)
+	must(err)
+
+	return handle
+}
+
+// CurrentWindowHandleE
+// returns current window handle
+func (w *WebDriver) CurrentWindowHandleE() (string, error) {
+	handle, err := w.WebClient.WindowHandleContext(w.Context(), w.SessionId)
+	if err != nil {
+		return "", driverError("window handle", nil, err)
+	}
+
+	return handle, nil
+}
+
+func (w *WebDriver) CurrentWindowHandle() string {
+	handle, err := w.CurrentWindowHandleE()
+	must(err)
+
+	return handle
+}
+
+// CloseWindowE
+// closes current window
+// returns remaining window handles
+// switch to one of them to continue
+func (w *WebDriver) CloseWindowE() ([]string, error) {
+	handles, err := w.WebClient.CloseWindowContext(w.Context(), w.SessionId)
+	if err != nil {
+		return nil, driverError("close window", nil, err)
+	}
+
+	return handles, nil
+}
+
+func (w *WebDriver) CloseWindow() []string {
+	handles, err := w.CloseWindowE()
+	must(err)
+
+	return handles
+}
+
+// SwitchWindowE
+// switches to window by handle
+func (w *WebDriver) SwitchWindowE(handle string) error {
+	err := w.WebClient.SwitchWindowContext(w.Context(), handle, w.SessionId)
+	if err != nil {
+		return driverError(fmt.Sprintf("switch window %q", handle), nil, err)
+	}
+
+	return nil
+}
+
+func (w *WebDriver) SwitchWindow(handle string) {
+	must(w.SwitchWindowE(handle))
+}
+
+// SwitchWindowByTitleE
+// switches to first window
+// which title contains title substring
+func (w *WebDriver) SwitchWindowByTitleE(title string) error {
+	return w.switchWindowBy(fmt.Sprintf("switch window by title %q", title), func() (bool, error) {
+		t, err := w.WebClient.TitleContext(w.Context(), w.SessionId)
+		return strings.Contains(t, title), err
+	})
+}
+
+func (w *WebDriver) SwitchWindowByTitle(title string) {
+	must(w.SwitchWindowByTitleE(title))
+}
+
+// SwitchWindowByURLE
+// switches to first window
+// which url contains url substring
+func (w *WebDriver) SwitchWindowByURLE(url string) error {
+	return w.switchWindowBy(fmt.Sprintf("switch window by url %q", url), func() (bool, error) {
+		u, err := w.WebClient.CurrentURLContext(w.Context(), w.SessionId)
+		return strings.Contains(u, url), err
+	})
+}
+
+func (w *WebDriver) SwitchWindowByURL(url string) {
+	must(w.SwitchWindowByURLE(url))
+}
+
+// switchWindowBy
+// switches to each window until match returns true
+// restores current window if none matches
+func (w *WebDriver) switchWindowBy(op string, match func() (bool, error)) error {
+	current, err := w.WebClient.WindowHandleContext(w.Context(), w.SessionId)
+	if err != nil {
+		return driverError(op, nil, err)
+	}
+
+	handles, err := w.WebClient.TabsContext(w.Context(), w.SessionId)
+	if err != nil {
+		return driverError(op, nil, err)
+	}
+
+	for _, handle := range handles {
+		if err := w.WebClient.SwitchWindowContext(w.Context(), handle, w.SessionId); err != nil {
+			return driverError(op, nil, err)
+		}
+
+		ok, err := match()
+		if err != nil {
+			return driverError(op, nil, err)
+		}
+
+		if ok {
+			return nil
+		}
+	}
+
+	if err := w.WebClient.SwitchWindowContext(w.Context(), current, w.SessionId); err != nil {
+		return driverError(op, nil, err)
+	}
+
+	return driverError(op, nil, client.ErrNoSuchWindow)
+}
+
+// GetWindowRectE
+// returns current window position and size
+func (w *WebDriver) GetWindowRectE() (*data.Rect, error) {
+	rect, err := w.WebClient.WindowRectContext(w.Context(), w.SessionId)
+	if err != nil {
+		return nil, driverError("window rect", nil, err)
+	}
+
+	return rect, nil
+}
+
+func (w *WebDriver) GetWindowRect() *data.Rect {
+	rect, err := w.GetWindowRectE()
+	must(err)
+
+	return rect
+}
+
+// SetWindowRectE
+// moves and resizes current window
+// nil rect fields keep current value
+// returns resulting rect, driver may adjust it to screen
+//
+//	d.SetWindowRect(&data.WindowRect{X: &x, Width: &width})
+func (w *WebDriver) SetWindowRectE(rect *data.WindowRect) (*data.Rect, error) {
+	r, err := w.WebClient.SetWindowRectContext(w.Context(), rect, w.SessionId)
+	if err != nil {
+		return nil, driverError("set window rect", nil, err)
+	}
+
+	return r, nil
+}
+
+func (w *WebDriver) SetWindowRect(rect *data.WindowRect) *data.Rect {
+	r, err := w.SetWindowRectE(rect)
+	must(err)
+
+	return r
+}
+
+// ResizeWindowE
+// sets current window size
+// keeps window position
+//
+//	d.ResizeWindow(1280, 800)
+func (w *WebDriver) ResizeWindowE(width, height float64) (*data.Rect, error) {
+	return w.SetWindowRectE(&data.WindowRect{Width: &width, Height: &height})
+}
+
+func (w *WebDriver) ResizeWindow(width, height float64) *data.Rect {
+	r, err := w.ResizeWindowE(width, height)
+	must(err)
+
+	return r
+}
+
+// MoveWindowE
+// sets current window position
+// keeps window size
+func (w *WebDriver) MoveWindowE(x, y float64) (*data.Rect, error) {
+	return w.SetWindowRectE(&data.WindowRect{X: &x, Y: &y})
+}
+
+func (w *WebDriver) MoveWindow(x, y float64) *data.Rect {
+	r, err := w.MoveWindowE(x, y)
+	must(err)
+
+	return r
+}
+
+func (w *WebDriver) MaximizeE() (*data.Rect, error) {
+	rect, err := w.WebClient.MaximizeContext(w.Context(), w.SessionId)
+	if err != nil {
+		return nil, driverError("maximize", nil, err)
+	}
+
+	return rect, nil
+}
+
+func (w *WebDriver) Maximize() *data.Rect {
+	rect, err := w.MaximizeE()
+	must(err)
+
+	return rect
+}
+
+func (w *WebDriver) MinimizeE() (*data.Rect, error) {
+	rect, err := w.WebClient.MinimizeContext(w.Context(), w.SessionId)
+	if err != nil {
+		return nil, driverError("minimize", nil, err)
+	}
+
+	return rect, nil
+}
+
+func (w *WebDriver) Minimize() *data.Rect {
+	rect, err := w.MinimizeE()
+	must(err)
+
+	return rect
+}
+
+func (w *WebDriver) FullscreenE() (*data.Rect, error) {
+	rect, err := w.WebClient.FullscreenContext(w.Context(), w.SessionId)
+	if err != nil {
+		return nil, driverError("fullscreen", nil, err)
+	}
+
+	return rect, nil
+}
+
+func (w *WebDriver) Fullscreen() *data.Rect {
+	rect, err := w.FullscreenE()
+	must(err)
+
+	return rect
+}
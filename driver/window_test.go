@@ -0,0 +1,95 @@
+package driver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mcsymiv/gost/client"
+	"github.com/mcsymiv/gost/data"
+	"github.com/mcsymiv/gost/fake"
+)
+
+func TestWindows(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home).Title = "Home"
+	srv.Page(home + "/docs").Title = "Docs"
+
+	d.Open(home)
+	main := d.CurrentWindowHandle()
+
+	tab := d.NewTab()
+	win := d.NewWindow("window")
+	if tab == "" || win == "" || tab == win {
+		t.Fatalf("unexpected handles: %q, %q", tab, win)
+	}
+
+	if h := d.CurrentWindowHandle(); h != main {
+		t.Errorf("expected new window not to switch, got: %q", h)
+	}
+
+	d.SwitchWindow(win)
+	d.Open(home + "/docs")
+
+	d.SwitchWindowByTitle("Home")
+	if h := d.CurrentWindowHandle(); h != main {
+		t.Errorf("expected switch by title to main window, got: %q", h)
+	}
+
+	d.SwitchWindowByURL("/docs")
+	if h := d.CurrentWindowHandle(); h != win {
+		t.Errorf("expected switch by url to new window, got: %q", h)
+	}
+
+	if err := d.SwitchWindowByTitleE("Missing"); !errors.Is(err, client.ErrNoSuchWindow) {
+		t.Errorf("expected no such window, got: %v", err)
+	}
+
+	if h := d.CurrentWindowHandle(); h != win {
+		t.Errorf("expected current window restored, got: %q", h)
+	}
+
+	x, y := 0.0, 0.0
+	rect := d.SetWindowRect(&data.WindowRect{X: &x, Y: &y})
+	if rect.X != 0 || rect.Y != 0 || rect.Width != 1280 {
+		t.Errorf("unexpected rect: %+v", rect)
+	}
+
+	rect = d.MoveWindow(20, 30)
+	if rect.X != 20 || rect.Y != 30 || rect.Width != 1280 {
+		t.Errorf("unexpected moved rect: %+v", rect)
+	}
+
+	rect = d.ResizeWindow(800, 600)
+	if rect.X != 20 || rect.Y != 30 || rect.Width != 800 || rect.Height != 600 {
+		t.Errorf("expected resize to keep position, got: %+v", rect)
+	}
+
+	if r := d.Maximize(); r.Width <= 800 {
+		t.Errorf("expected maximized window, got: %+v", r)
+	}
+
+	d.Minimize()
+	d.Fullscreen()
+
+	if r := d.GetWindowRect(); r.Width != 1920 {
+		t.Errorf("expected fullscreen rect, got: %+v", r)
+	}
+
+	handles := d.CloseWindow()
+	if len(handles) != 2 {
+		t.Fatalf("expected 2 remaining windows, got: %v", handles)
+	}
+
+	if _, err := d.CurrentWindowHandleE(); !errors.Is(err, client.ErrNoSuchWindow) {
+		t.Errorf("expected no such window after close, got: %v", err)
+	}
+
+	d.SwitchWindow(main)
+	if u := d.CurrentURL(); u != home {
+		t.Errorf("unexpected url: %q", u)
+	}
+
+	if err := d.TabE(5); !errors.Is(err, client.ErrNoSuchWindow) {
+		t.Errorf("expected no such window on tab index, got: %v", err)
+	}
+}
@@ -0,0 +1,363 @@
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// pointer
+// state of pointer input source
+type pointer struct {
+	x, y float64
+
+	// node
+	// element under pointer
+	node *Node
+
+	// down
+	// element where button was pressed
+	down *Node
+
+	// clicked
+	// element of previous click, for dblclick
+	clicked *Node
+}
+
+// performActions
+// dispatches input sources actions tick by tick
+// keyDown types into focused input,
+// pointerDown and pointerUp of left button on the same element click it,
+// pointer and wheel scroll events are fired to element On handlers
+func performActions(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	raw, ok := body["actions"].([]interface{})
+	if !ok {
+		return nil, NewError("invalid argument", "missing actions")
+	}
+
+	var sources []map[string]interface{}
+	ticks := 0
+	for _, src := range raw {
+		source, ok := src.(map[string]interface{})
+		if !ok {
+			return nil, NewError("invalid argument", "invalid input source")
+		}
+
+		actions, ok := source["actions"].([]interface{})
+		if !ok {
+			return nil, NewError("invalid argument", "missing input source actions")
+		}
+
+		if len(actions) > ticks {
+			ticks = len(actions)
+		}
+
+		if source["type"] == "wheel" && s.server.NoWheel {
+			return nil, NewError("invalid argument", "unknown input source type: wheel")
+		}
+
+		sources = append(sources, source)
+	}
+
+	for i := 0; i < ticks; i++ {
+		for _, source := range sources {
+			actions := source["actions"].([]interface{})
+			if i >= len(actions) {
+				continue
+			}
+
+			action, ok := actions[i].(map[string]interface{})
+			if !ok {
+				return nil, NewError("invalid argument", "invalid action")
+			}
+
+			id, _ := source["id"].(string)
+
+			var err error
+			switch source["type"] {
+			case "key":
+				s.keyAction(action)
+			case "pointer":
+				err = s.pointerAction(id, action)
+			case "wheel":
+				err = s.wheelAction(action)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	s.Actions = append(s.Actions, sources)
+	return nil, nil
+}
+
+// releaseActions
+// releases pressed buttons
+// pointer stays over its element
+func releaseActions(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	for _, p := range s.pointers {
+		p.down = nil
+		p.clicked = nil
+	}
+
+	s.modifiers = nil
+
+	return nil, nil
+}
+
+// modifierNames
+// W3C modifier keys, recorded in Session.Pressed
+var modifierNames = map[string]string{
+	"\ue008": "Shift",
+	"\ue009": "Control",
+	"\ue00a": "Alt",
+	"\ue03d": "Meta",
+}
+
+// nullKey
+// releases all modifiers in send keys
+const nullKey = "\ue000"
+
+func (s *Session) keyAction(action map[string]interface{}) {
+	key, _ := action["value"].(string)
+
+	switch action["type"] {
+	case "keyDown":
+		if s.modifier(key, true) || s.shortcut(key) {
+			return
+		}
+
+		active := s.active()
+		if active.Tag == "input" || active.Tag == "textarea" {
+			active.Attrs["value"] += typed(key)
+		}
+	case "keyUp":
+		s.modifier(key, false)
+	}
+}
+
+// modifier
+// presses or releases modifier key
+// false if key is not a modifier
+func (s *Session) modifier(key string, down bool) bool {
+	if _, ok := modifierNames[key]; !ok {
+		return false
+	}
+
+	for i, m := range s.modifiers {
+		if m == key {
+			s.modifiers = append(s.modifiers[:i], s.modifiers[i+1:]...)
+			break
+		}
+	}
+
+	if down {
+		s.modifiers = append(s.modifiers, key)
+	}
+
+	return true
+}
+
+// shortcut
+// records key pressed with Control, Alt or Meta
+// such key is not typed
+func (s *Session) shortcut(key string) bool {
+	names := []string{}
+	typing := true
+
+	for _, m := range s.modifiers {
+		names = append(names, modifierNames[m])
+		if modifierNames[m] != "Shift" {
+			typing = false
+		}
+	}
+
+	if typing {
+		return false
+	}
+
+	s.Pressed = append(s.Pressed, strings.Join(append(names, key), "+"))
+	return true
+}
+
+// sendText
+// types send keys text into element
+// modifiers are sticky until pressed again or NullKey,
+// and released at the end
+func (s *Session) sendText(n *Node, text string) {
+	for _, r := range text {
+		key := string(r)
+
+		switch {
+		case key == nullKey:
+			s.modifiers = nil
+		case modifierNames[key] != "":
+			held := false
+			for _, m := range s.modifiers {
+				held = held || m == key
+			}
+
+			s.modifier(key, !held)
+		case s.shortcut(key):
+		default:
+			n.Attrs["value"] += typed(key)
+		}
+	}
+
+	s.modifiers = nil
+}
+
+func (s *Session) pointerAction(id string, action map[string]interface{}) error {
+	if s.pointers == nil {
+		s.pointers = map[string]*pointer{}
+	}
+
+	p, ok := s.pointers[id]
+	if !ok {
+		p = &pointer{}
+		s.pointers[id] = p
+	}
+
+	switch action["type"] {
+	case "pointerMove":
+		x, _ := action["x"].(float64)
+		y, _ := action["y"].(float64)
+
+		origin, err := s.fromJSON(action["origin"])
+		if err != nil {
+			return err
+		}
+
+		switch o := origin.(type) {
+		case *Node:
+			if !o.Displayed() {
+				return NewError("move target out of bounds", fmt.Sprintf("element <%s> is not displayed", o.Tag))
+			}
+
+			p.x = o.Rect.X + o.Rect.Width/2 + x
+			p.y = o.Rect.Y + o.Rect.Height/2 + y
+
+			// element without rect is the target itself
+			target := o
+			if hit := s.Document().Root.hit(p.x, p.y); hit != nil && o.Rect.Width > 0 {
+				target = hit
+			}
+
+			p.hover(s, target)
+			return nil
+		case string:
+			if o == "pointer" {
+				p.x, p.y = p.x+x, p.y+y
+				break
+			}
+
+			p.x, p.y = x, y
+		default:
+			p.x, p.y = x, y
+		}
+
+		p.hover(s, s.Document().Root.hit(p.x, p.y))
+	case "pointerDown":
+		p.down = p.node
+		s.fire(p.node, "mousedown")
+
+		if button, _ := action["button"].(float64); button == 2 {
+			s.fire(p.node, "contextmenu")
+		}
+	case "pointerUp":
+		s.fire(p.node, "mouseup")
+
+		button, _ := action["button"].(float64)
+		if p.down != nil && p.down == p.node && button == 0 {
+			s.clickNode(p.node)
+
+			if p.clicked == p.node {
+				s.fire(p.node, "dblclick")
+				p.clicked = nil
+			} else {
+				p.clicked = p.node
+			}
+		}
+
+		p.down = nil
+	}
+
+	return nil
+}
+
+// wheelAction
+// fires scroll event on origin element
+// or on element at viewport point, body if none
+func (s *Session) wheelAction(action map[string]interface{}) error {
+	if action["type"] != "scroll" {
+		return nil
+	}
+
+	origin, err := s.fromJSON(action["origin"])
+	if err != nil {
+		return err
+	}
+
+	if n, ok := origin.(*Node); ok {
+		s.fire(n, "scroll")
+		return nil
+	}
+
+	x, _ := action["x"].(float64)
+	y, _ := action["y"].(float64)
+
+	n := s.Document().Root.hit(x, y)
+	if n == nil {
+		n = s.Document().Body()
+	}
+
+	s.fire(n, "scroll")
+	return nil
+}
+
+// hover
+// moves pointer onto element
+// fires mouseover when element changes
+func (p *pointer) hover(s *Session, n *Node) {
+	if n != nil && n != p.node {
+		s.fire(n, "mouseover")
+	}
+
+	p.node = n
+}
+
+// fire
+// runs element event handler
+func (s *Session) fire(n *Node, event string) {
+	if n == nil {
+		return
+	}
+
+	if fn, ok := n.On[event]; ok {
+		fn(s, n)
+	}
+}
+
+// hit
+// last displayed element in document order
+// which rect contains point
+func (n *Node) hit(x, y float64) *Node {
+	var target *Node
+
+	n.walk(func(c *Node) bool {
+		if !c.Displayed() {
+			return false
+		}
+
+		r := c.Rect
+		if r.Width > 0 && r.Height > 0 && x >= r.X && x < r.X+r.Width && y >= r.Y && y < r.Y+r.Height {
+			target = c
+		}
+
+		return true
+	})
+
+	return target
+}
@@ -0,0 +1,145 @@
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Dialog
+// user prompt opened in window
+// Type is "alert", "confirm" or "prompt"
+type Dialog struct {
+	Type string
+	Text string
+
+	// OnClose
+	// called with accepted state and prompt text
+	// when dialog is accepted or dismissed
+	OnClose func(accepted bool, text string)
+
+	input string
+}
+
+// OpenDialog
+// opens dialog in current window
+// i.e. from OnClick handler
+func (s *Session) OpenDialog(d *Dialog) {
+	s.current.dialog = d
+}
+
+// Dialog
+// dialog opened in current window, nil if none
+func (s *Session) Dialog() *Dialog {
+	if s.current == nil {
+		return nil
+	}
+
+	return s.current.dialog
+}
+
+// closeDialog
+// closes current window dialog
+// dismissed prompt returns no text
+func (s *Session) closeDialog(accepted bool) {
+	d := s.current.dialog
+	s.current.dialog = nil
+
+	if d.OnClose == nil {
+		return
+	}
+
+	if !accepted || d.Type != "prompt" {
+		d.OnClose(accepted, "")
+		return
+	}
+
+	d.OnClose(accepted, d.input)
+}
+
+// unhandledDialog
+// applies session unhandledPromptBehavior
+// to dialog opened before command
+// default behavior is "dismiss and notify"
+func (s *Session) unhandledDialog() error {
+	d := s.Dialog()
+	if d == nil {
+		return nil
+	}
+
+	behavior := s.UnhandledPromptBehavior
+	if behavior == "" {
+		behavior = "dismiss and notify"
+	}
+
+	if behavior != "ignore" {
+		s.closeDialog(strings.HasPrefix(behavior, "accept"))
+	}
+
+	if behavior == "accept" || behavior == "dismiss" {
+		return nil
+	}
+
+	err := NewError("unexpected alert open", fmt.Sprintf("%s dialog: %s", d.Type, d.Text))
+	err.Data = map[string]interface{}{"text": d.Text}
+
+	return err
+}
+
+func (s *Session) openDialog() (*Dialog, error) {
+	d := s.Dialog()
+	if d == nil {
+		return nil, NewError("no such alert", "no dialog is opened")
+	}
+
+	return d, nil
+}
+
+func alertText(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	d, err := s.openDialog()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.Text, nil
+}
+
+// sendAlertText
+// types text into prompt
+// alert and confirm are not interactable
+func sendAlertText(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	d, err := s.openDialog()
+	if err != nil {
+		return nil, err
+	}
+
+	text, ok := body["text"].(string)
+	if !ok {
+		return nil, NewError("invalid argument", "text is not a string")
+	}
+
+	if d.Type != "prompt" {
+		return nil, NewError("element not interactable", fmt.Sprintf("%s dialog does not accept text", d.Type))
+	}
+
+	d.input = text
+	return nil, nil
+}
+
+func acceptAlert(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	if _, err := s.openDialog(); err != nil {
+		return nil, err
+	}
+
+	s.closeDialog(true)
+	return nil, nil
+}
+
+func dismissAlert(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	if _, err := s.openDialog(); err != nil {
+		return nil, err
+	}
+
+	s.closeDialog(false)
+	return nil, nil
+}
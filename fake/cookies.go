@@ -0,0 +1,114 @@
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/mcsymiv/gost/data"
+)
+
+// host
+// current document host
+// empty for about:blank
+func (s *Session) host() string {
+	u, err := url.Parse(s.Document().URL)
+	if err != nil {
+		return ""
+	}
+
+	return u.Hostname()
+}
+
+// visible
+// cookies matching current document domain
+func (s *Session) visible() []*data.Cookie {
+	host := s.host()
+	cookies := []*data.Cookie{}
+
+	for _, c := range s.Cookies {
+		domain := strings.TrimPrefix(c.Domain, ".")
+		if host != "" && (host == domain || strings.HasSuffix(host, "."+domain)) {
+			cookies = append(cookies, c)
+		}
+	}
+
+	return cookies
+}
+
+func getCookies(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	return s.visible(), nil
+}
+
+func getCookie(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	name := r.PathValue("name")
+
+	for _, c := range s.visible() {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+
+	return nil, NewError("no such cookie", fmt.Sprintf("cookie %s is not set", name))
+}
+
+func addCookie(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	b, err := json.Marshal(body["cookie"])
+	if err != nil {
+		return nil, NewError("invalid argument", err.Error())
+	}
+
+	c := new(data.Cookie)
+	if err := json.Unmarshal(b, c); err != nil || c.Name == "" {
+		return nil, NewError("invalid argument", fmt.Sprintf("invalid cookie %s", b))
+	}
+
+	host := s.host()
+	if host == "" {
+		return nil, NewError("invalid cookie domain", fmt.Sprintf("document %s has no domain", s.Document().URL))
+	}
+
+	if c.Domain == "" {
+		c.Domain = host
+	}
+
+	if c.Path == "" {
+		c.Path = "/"
+	}
+
+	s.removeCookie(c.Name, c.Domain)
+	s.Cookies = append(s.Cookies, c)
+
+	return nil, nil
+}
+
+func deleteCookie(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	for _, c := range s.visible() {
+		if c.Name == r.PathValue("name") {
+			s.removeCookie(c.Name, c.Domain)
+		}
+	}
+
+	return nil, nil
+}
+
+func deleteCookies(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	for _, c := range s.visible() {
+		s.removeCookie(c.Name, c.Domain)
+	}
+
+	return nil, nil
+}
+
+func (s *Session) removeCookie(name, domain string) {
+	cookies := s.Cookies[:0]
+	for _, c := range s.Cookies {
+		if c.Name != name || c.Domain != domain {
+			cookies = append(cookies, c)
+		}
+	}
+
+	s.Cookies = cookies
+}
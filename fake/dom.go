@@ -0,0 +1,337 @@
+package fake
+
+import (
+	"strings"
+
+	"github.com/mcsymiv/gost/data"
+)
+
+// Node
+// element of fake DOM model
+type Node struct {
+	Tag      string
+	Attrs    map[string]string
+	Text     string
+	Children []*Node
+	Parent   *Node
+
+	// Hidden
+	// element and its children are not displayed
+	Hidden bool
+
+	// OnClick
+	// called on element click
+	// i.e. to change DOM or navigate
+	OnClick func(s *Session, n *Node)
+
+	// On
+	// pointer and scroll event handlers by event type
+	// i.e. "mouseover", "dblclick", "contextmenu", "scroll"
+	On map[string]func(s *Session, n *Node)
+
+	// Frame
+	// content document of iframe element
+	Frame *Document
+
+	// Shadow
+	// open shadow root of host element
+	// its children are not found from document
+	Shadow *Node
+
+	// Style
+	// computed css values
+	Style map[string]string
+
+	// Rect
+	// element position and size
+	Rect data.Rect
+}
+
+// NodeOption
+// configures Node in El builder
+type NodeOption func(*Node)
+
+// El
+// builds fake DOM element
+//
+//	fake.El("button", fake.Attr("id", "save"), fake.Text("Save"))
+func El(tag string, opts ...NodeOption) *Node {
+	n := &Node{
+		Tag:   tag,
+		Attrs: map[string]string{},
+	}
+
+	for _, opt := range opts {
+		opt(n)
+	}
+
+	return n
+}
+
+// Style
+// sets computed css value
+func Style(name, value string) NodeOption {
+	return func(n *Node) {
+		if n.Style == nil {
+			n.Style = map[string]string{}
+		}
+
+		n.Style[name] = value
+	}
+}
+
+// Rect
+// sets element position and size
+func Rect(x, y, width, height float64) NodeOption {
+	return func(n *Node) {
+		n.Rect = data.Rect{X: x, Y: y, Width: width, Height: height}
+	}
+}
+
+func Attr(name, value string) NodeOption {
+	return func(n *Node) {
+		n.Attrs[name] = value
+	}
+}
+
+func Text(text string) NodeOption {
+	return func(n *Node) {
+		n.Text = text
+	}
+}
+
+func Hidden() NodeOption {
+	return func(n *Node) {
+		n.Hidden = true
+	}
+}
+
+func OnClick(fn func(s *Session, n *Node)) NodeOption {
+	return func(n *Node) {
+		n.OnClick = fn
+	}
+}
+
+// On
+// sets pointer or scroll event handler
+//
+//	fake.On("contextmenu", func(s *fake.Session, n *fake.Node) { ... })
+func On(event string, fn func(s *Session, n *Node)) NodeOption {
+	return func(n *Node) {
+		if n.On == nil {
+			n.On = map[string]func(s *Session, n *Node){}
+		}
+
+		n.On[event] = fn
+	}
+}
+
+// Frame
+// sets iframe content document
+//
+//	fake.El("iframe", fake.Attr("id", "checkout"), fake.Frame(
+//		fake.El("input", fake.Attr("id", "card")),
+//	))
+func Frame(nodes ...*Node) NodeOption {
+	return func(n *Node) {
+		n.Frame = NewDocument("about:srcdoc", nodes...)
+	}
+}
+
+// Shadow
+// attaches shadow root with children to host element
+//
+//	fake.El("my-app", fake.Shadow(
+//		fake.El("button", fake.Attr("id", "submit")),
+//	))
+func Shadow(children ...*Node) NodeOption {
+	return func(n *Node) {
+		root := El("#shadow-root", Child(children...))
+		root.Parent = n
+		n.Shadow = root
+	}
+}
+
+// Child
+// appends children to element
+func Child(children ...*Node) NodeOption {
+	return func(n *Node) {
+		n.Append(children...)
+	}
+}
+
+// Append
+// adds children to the end of element
+func (n *Node) Append(children ...*Node) {
+	for _, c := range children {
+		c.Parent = n
+		n.Children = append(n.Children, c)
+	}
+}
+
+// Remove
+// detaches element from its parent
+// element references become stale
+func (n *Node) Remove() {
+	if n.Parent == nil {
+		return
+	}
+
+	kids := n.Parent.Children[:0]
+	for _, c := range n.Parent.Children {
+		if c != n {
+			kids = append(kids, c)
+		}
+	}
+
+	n.Parent.Children = kids
+	n.Parent = nil
+}
+
+// Attr
+// returns attribute value and presence
+func (n *Node) Attr(name string) (string, bool) {
+	v, ok := n.Attrs[name]
+	return v, ok
+}
+
+// SetAttr
+// sets attribute value
+func (n *Node) SetAttr(name, value string) {
+	n.Attrs[name] = value
+}
+
+// Displayed
+// checks element and its ancestors visibility
+func (n *Node) Displayed() bool {
+	for p := n; p != nil; p = p.Parent {
+		if p.Hidden {
+			return false
+		}
+
+		if _, ok := p.Attrs["hidden"]; ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// TextContent
+// rendered text of displayed element and its children
+func (n *Node) TextContent() string {
+	if !n.Displayed() {
+		return ""
+	}
+
+	var parts []string
+	n.walk(func(c *Node) bool {
+		if c.Hidden {
+			return false
+		}
+
+		if t := strings.TrimSpace(c.Text); t != "" {
+			parts = append(parts, t)
+		}
+
+		return true
+	})
+
+	return strings.Join(parts, " ")
+}
+
+// walk
+// visits element and its descendants in document order
+// fn returns false to skip children
+func (n *Node) walk(fn func(*Node) bool) {
+	if !fn(n) {
+		return
+	}
+
+	for _, c := range n.Children {
+		c.walk(fn)
+	}
+}
+
+// descendants
+// all element descendants in document order
+func (n *Node) descendants() []*Node {
+	var nodes []*Node
+
+	for _, c := range n.Children {
+		c.walk(func(d *Node) bool {
+			nodes = append(nodes, d)
+			return true
+		})
+	}
+
+	return nodes
+}
+
+// root
+// topmost ancestor of element
+func (n *Node) root() *Node {
+	r := n
+	for r.Parent != nil {
+		r = r.Parent
+	}
+
+	return r
+}
+
+// Find
+// returns first element matching css selector
+// nil if none
+func (n *Node) Find(css string) *Node {
+	nodes, err := findCss(css, n)
+	if err != nil || len(nodes) == 0 {
+		return nil
+	}
+
+	return nodes[0]
+}
+
+// Document
+// page served by fake driver on navigation
+type Document struct {
+	URL   string
+	Title string
+
+	// Root
+	// virtual document node, parent of html element
+	Root *Node
+}
+
+// NewDocument
+// builds html document with nodes as body children
+func NewDocument(url string, nodes ...*Node) *Document {
+	root := El("#document", Child(
+		El("html", Child(
+			El("head"),
+			El("body", Child(nodes...)),
+		)),
+	))
+
+	return &Document{
+		URL:  url,
+		Root: root,
+	}
+}
+
+// Body
+// returns document body element
+func (d *Document) Body() *Node {
+	return d.Root.Find("body")
+}
+
+// Find
+// returns first document element matching css selector
+func (d *Document) Find(css string) *Node {
+	return d.Root.Find(css)
+}
+
+// contains
+// checks element is attached to document
+func (d *Document) contains(n *Node) bool {
+	return n.root() == d.Root
+}
@@ -0,0 +1,159 @@
+package fake
+
+import (
+	"net/http"
+	"strings"
+)
+
+// property
+// DOM property of element
+// boolean properties reflect attribute presence
+// other properties fall back to attribute value
+func property(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	n, err := s.pathElement(r)
+	if err != nil {
+		return nil, err
+	}
+
+	name := r.PathValue("name")
+
+	switch name {
+	case "checked", "selected", "disabled", "hidden", "required", "readOnly":
+		_, ok := n.Attrs[strings.ToLower(name)]
+		return ok, nil
+	case "tagName":
+		return strings.ToUpper(n.Tag), nil
+	case "textContent", "innerText":
+		return n.TextContent(), nil
+	case "className":
+		return n.Attrs["class"], nil
+	case "value":
+		return n.Attrs["value"], nil
+	}
+
+	v, ok := n.Attrs[name]
+	if !ok {
+		return nil, nil
+	}
+
+	return v, nil
+}
+
+func cssValue(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	n, err := s.pathElement(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.Style[r.PathValue("name")], nil
+}
+
+func tagName(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	n, err := s.pathElement(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.Tag, nil
+}
+
+func elementRect(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	n, err := s.pathElement(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.Rect, nil
+}
+
+func enabled(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	n, err := s.pathElement(r)
+	if err != nil {
+		return nil, err
+	}
+
+	_, disabled := n.Attrs["disabled"]
+	return !disabled, nil
+}
+
+// selected
+// checked checkbox, radio
+// or selected option
+func selected(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	n, err := s.pathElement(r)
+	if err != nil {
+		return nil, err
+	}
+
+	_, checked := n.Attrs["checked"]
+	_, selected := n.Attrs["selected"]
+
+	return checked || selected, nil
+}
+
+// roles
+// implicit ARIA roles of tags
+var roles = map[string]string{
+	"a":        "link",
+	"button":   "button",
+	"select":   "combobox",
+	"textarea": "textbox",
+	"h1":       "heading",
+	"h2":       "heading",
+	"h3":       "heading",
+	"img":      "img",
+	"ul":       "list",
+	"li":       "listitem",
+	"option":   "option",
+	"form":     "form",
+	"nav":      "navigation",
+	"main":     "main",
+}
+
+func computedRole(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	n, err := s.pathElement(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if role, ok := n.Attrs["role"]; ok {
+		return role, nil
+	}
+
+	if n.Tag == "input" {
+		switch n.Attrs["type"] {
+		case "checkbox", "radio":
+			return n.Attrs["type"], nil
+		case "button", "submit", "reset":
+			return "button", nil
+		}
+
+		return "textbox", nil
+	}
+
+	return roles[n.Tag], nil
+}
+
+// computedLabel
+// accessible name from aria-label, text content,
+// placeholder or title
+func computedLabel(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	n, err := s.pathElement(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if label := n.Attrs["aria-label"]; label != "" {
+		return label, nil
+	}
+
+	if text := strings.TrimSpace(n.TextContent()); text != "" {
+		return text, nil
+	}
+
+	if placeholder := n.Attrs["placeholder"]; placeholder != "" {
+		return placeholder, nil
+	}
+
+	return n.Attrs["title"], nil
+}
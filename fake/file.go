@@ -0,0 +1,89 @@
+package fake
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+)
+
+// File
+// returns content of file uploaded with /se/file
+// by its driver host path
+func (srv *Server) File(name string) ([]byte, bool) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	b, ok := srv.files[name]
+	return b, ok
+}
+
+// uploadFile
+// unzips single file archive
+// and stores it under new driver host path
+func uploadFile(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	b64, ok := body["file"].(string)
+	if !ok {
+		return nil, NewError("invalid argument", "missing file")
+	}
+
+	b, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return nil, NewError("invalid argument", fmt.Sprintf("file is not base64: %v", err))
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		return nil, NewError("invalid argument", fmt.Sprintf("file is not zip: %v", err))
+	}
+
+	if len(zr.File) != 1 {
+		return nil, NewError("invalid argument", fmt.Sprintf("expected single file, got: %d", len(zr.File)))
+	}
+
+	f, err := zr.File[0].Open()
+	if err != nil {
+		return nil, NewError("invalid argument", err.Error())
+	}
+	defer f.Close()
+
+	content, err := io.ReadAll(f)
+	if err != nil {
+		return nil, NewError("invalid argument", err.Error())
+	}
+
+	name := path.Join("/tmp/upload", fmt.Sprintf("%d", len(s.server.files)), zr.File[0].Name)
+	s.server.files[name] = content
+
+	return name, nil
+}
+
+// setFiles
+// sets newline separated paths to file input
+// paths must exist locally or be uploaded
+func (s *Session) setFiles(n *Node, text string) error {
+	paths := strings.Split(text, "\n")
+
+	_, multiple := n.Attrs["multiple"]
+	if len(paths) > 1 && !multiple {
+		return NewError("invalid argument", "file input does not accept multiple files")
+	}
+
+	for _, p := range paths {
+		if _, ok := s.server.files[p]; ok {
+			continue
+		}
+
+		if _, err := os.Stat(p); err != nil {
+			return NewError("invalid argument", fmt.Sprintf("File not found: %s", p))
+		}
+	}
+
+	n.Attrs["value"] = text
+	return nil
+}
@@ -0,0 +1,98 @@
+package fake
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// editable
+// input, textarea or contenteditable element
+func editable(n *Node) bool {
+	if _, ok := n.Attrs["contenteditable"]; ok {
+		return true
+	}
+
+	return n.Tag == "input" || n.Tag == "textarea"
+}
+
+// clear
+// resets element value
+// disabled and readonly elements are not cleared
+func clear(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	n, err := s.pathElement(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if !editable(n) {
+		return nil, NewError("invalid element state", fmt.Sprintf("element <%s> is not editable", n.Tag))
+	}
+
+	_, disabled := n.Attrs["disabled"]
+	_, readonly := n.Attrs["readonly"]
+	if disabled || readonly {
+		return nil, NewError("invalid element state", fmt.Sprintf("element <%s> is disabled or readonly", n.Tag))
+	}
+
+	if !n.Displayed() {
+		return nil, NewError("element not interactable", fmt.Sprintf("element <%s> is not displayed", n.Tag))
+	}
+
+	s.Active = n
+	n.Attrs["value"] = ""
+
+	return nil, nil
+}
+
+// activate
+// default click behavior of form controls
+// toggles checkbox, checks radio and selects option
+func activate(n *Node) {
+	if _, disabled := n.Attrs["disabled"]; disabled {
+		return
+	}
+
+	switch {
+	case n.Tag == "input" && n.Attrs["type"] == "checkbox":
+		if _, ok := n.Attrs["checked"]; ok {
+			delete(n.Attrs, "checked")
+			return
+		}
+
+		n.Attrs["checked"] = ""
+	case n.Tag == "input" && n.Attrs["type"] == "radio":
+		name, named := n.Attrs["name"]
+		for _, o := range n.root().descendants() {
+			if named && o.Tag == "input" && o.Attrs["type"] == "radio" && o.Attrs["name"] == name {
+				delete(o.Attrs, "checked")
+			}
+		}
+
+		n.Attrs["checked"] = ""
+	case n.Tag == "option":
+		sel := n.Parent
+		for sel != nil && sel.Tag != "select" {
+			sel = sel.Parent
+		}
+
+		if sel == nil {
+			return
+		}
+
+		if _, multiple := sel.Attrs["multiple"]; multiple {
+			if _, ok := n.Attrs["selected"]; ok {
+				delete(n.Attrs, "selected")
+				return
+			}
+
+			n.Attrs["selected"] = ""
+			return
+		}
+
+		for _, o := range sel.descendants() {
+			delete(o.Attrs, "selected")
+		}
+
+		n.Attrs["selected"] = ""
+	}
+}
@@ -0,0 +1,62 @@
+package fake
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mcsymiv/gost/config"
+)
+
+// switchFrame
+// enters iframe by index or element reference
+// null id switches to top-level document
+func switchFrame(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	switch id := body["id"].(type) {
+	case nil:
+		s.current.frames = nil
+	case float64:
+		var frames []*Node
+		for _, n := range s.Document().Root.descendants() {
+			if n.Frame != nil {
+				frames = append(frames, n)
+			}
+		}
+
+		i := int(id)
+		if i < 0 || i >= len(frames) {
+			return nil, NewError("no such frame", fmt.Sprintf("frame index %d out of %d frames", i, len(frames)))
+		}
+
+		s.current.frames = append(s.current.frames, frames[i].Frame)
+	case map[string]interface{}:
+		eid, _ := id[config.WebElementIdentifier].(string)
+
+		n, err := s.element(eid)
+		if err != nil {
+			return nil, err
+		}
+
+		if n.Frame == nil {
+			return nil, NewError("no such frame", fmt.Sprintf("element %s is not a frame", eid))
+		}
+
+		s.current.frames = append(s.current.frames, n.Frame)
+	default:
+		return nil, NewError("invalid argument", fmt.Sprintf("invalid frame id %v", id))
+	}
+
+	s.Active = nil
+	return nil, nil
+}
+
+// parentFrame
+// leaves innermost frame
+// stays on top-level document
+func parentFrame(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	if n := len(s.current.frames); n > 0 {
+		s.current.frames = s.current.frames[:n-1]
+	}
+
+	s.Active = nil
+	return nil, nil
+}
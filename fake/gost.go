@@ -0,0 +1,67 @@
+package fake
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mcsymiv/gost/capabilities"
+	"github.com/mcsymiv/gost/config"
+	"github.com/mcsymiv/gost/driver"
+	"github.com/mcsymiv/gost/service"
+)
+
+// TestConfig
+// default config with short waits
+// for fake driver tests
+func TestConfig() *config.WebConfig {
+	conf := config.DefaultConfig()
+	conf.ScreenshotOnFail = false
+	conf.WaitForTimeout = 1
+	conf.WaitForInterval = 20
+	conf.RetryDelay = 5
+	conf.RetryMaxDelay = 20
+
+	return conf
+}
+
+// Gost
+// starts fake driver and service proxy,
+// replaces config.Config and creates new session
+// servers are closed, session deleted
+// and previous config.Config restored on test cleanup
+//
+//	d, srv := fake.Gost(t)
+//	srv.Page("https://example.com", fake.El("button", fake.Text("Save")))
+//	d.Open("https://example.com")
+//	d.Cl("Save")
+func Gost(t testing.TB, capsFn ...capabilities.CapabilitiesFunc) (*driver.WebDriver, *Server) {
+	t.Helper()
+
+	srv := NewServer()
+
+	prev := config.Config
+	conf := TestConfig()
+	conf.WebDriverAddr = srv.URL
+	conf.ScreenshotsPath = t.TempDir()
+	config.Config = conf
+
+	proxy := httptest.NewServer(service.Handler())
+	conf.WebServerAddr = proxy.URL
+
+	wd, err := driver.NewDriverE(capsFn...)
+	if err != nil {
+		proxy.Close()
+		srv.Close()
+		config.Config = prev
+		t.Fatalf("error on fake driver session: %v", err)
+	}
+
+	t.Cleanup(func() {
+		wd.QuitE()
+		proxy.Close()
+		srv.Close()
+		config.Config = prev
+	})
+
+	return wd, srv
+}
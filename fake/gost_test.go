@@ -0,0 +1,23 @@
+package fake
+
+import (
+	"testing"
+
+	"github.com/mcsymiv/gost/config"
+)
+
+func TestGostRestoresConfig(t *testing.T) {
+	prev := config.Config
+
+	t.Run("session", func(t *testing.T) {
+		Gost(t)
+
+		if config.Config == prev || config.Config.ScreenshotOnFail {
+			t.Errorf("expected test config, got: %+v", config.Config)
+		}
+	})
+
+	if config.Config != prev {
+		t.Errorf("expected config restored, got: %+v", config.Config)
+	}
+}
@@ -0,0 +1,667 @@
+package fake
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mcsymiv/gost/data"
+)
+
+// find
+// resolves W3C locator strategy from element
+// supports css selector, xpath, tag name and link text subsets
+func find(using, value string, ctx *Node) ([]*Node, error) {
+	switch using {
+	case data.ByCssSelector:
+		return findCss(value, ctx)
+	case data.ByXPath:
+		return findXPath(value, ctx)
+	case data.ByTagName:
+		return findCss(value, ctx)
+	case data.ByLinkText, data.ByPartialLinkText:
+		var nodes []*Node
+		for _, n := range ctx.descendants() {
+			if n.Tag != "a" {
+				continue
+			}
+
+			t := n.TextContent()
+			if t == value || (using == data.ByPartialLinkText && strings.Contains(t, value)) {
+				nodes = append(nodes, n)
+			}
+		}
+
+		return nodes, nil
+	}
+
+	return nil, fmt.Errorf("unsupported locator strategy: %s", using)
+}
+
+// splitTop
+// splits s by sep outside of brackets, parens and quotes
+func splitTop(s string, sep byte) []string {
+	var parts []string
+	var depth int
+	var quote byte
+	start := 0
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[' || c == '(':
+			depth++
+		case c == ']' || c == ')':
+			depth--
+		case c == sep && depth == 0:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, s[start:])
+}
+
+// closing
+// index of bracket closing the one at s[open]
+func closing(s string, open int) (int, error) {
+	var depth int
+	var quote byte
+
+	for i := open; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[' || c == '(':
+			depth++
+		case c == ']' || c == ')':
+			depth--
+			if depth == 0 {
+				return i, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("unbalanced brackets in %q", s)
+}
+
+func unquote(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+
+	return s
+}
+
+// element
+// excludes virtual document node from matches
+func element(n *Node) bool {
+	return !strings.HasPrefix(n.Tag, "#")
+}
+
+// cssSimple
+// compound selector, i.e. input#q.search[name='q']
+type cssSimple struct {
+	tag     string
+	id      string
+	classes []string
+	attrs   []cssAttr
+}
+
+type cssAttr struct {
+	name, op, value string
+}
+
+// cssPart
+// compound selector with combinator to the previous part
+type cssPart struct {
+	combinator byte
+	simple     *cssSimple
+}
+
+func findCss(sel string, ctx *Node) ([]*Node, error) {
+	var groups [][]*cssPart
+
+	for _, g := range splitTop(sel, ',') {
+		parts, err := parseCss(strings.TrimSpace(g))
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, parts)
+	}
+
+	var nodes []*Node
+	for _, n := range ctx.descendants() {
+		for _, parts := range groups {
+			if matchCss(n, parts) {
+				nodes = append(nodes, n)
+				break
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func parseCss(sel string) ([]*cssPart, error) {
+	if sel == "" {
+		return nil, fmt.Errorf("invalid css selector: empty")
+	}
+
+	var parts []*cssPart
+	var combinator byte = ' '
+
+	for i := 0; i < len(sel); {
+		c := sel[i]
+		switch {
+		case c == ' ':
+			i++
+		case c == '>':
+			combinator = '>'
+			i++
+		default:
+			end := i
+			for end < len(sel) && sel[end] != ' ' && sel[end] != '>' {
+				if sel[end] == '[' {
+					close, err := closing(sel, end)
+					if err != nil {
+						return nil, err
+					}
+					end = close
+				}
+				end++
+			}
+
+			simple, err := parseSimple(sel[i:end])
+			if err != nil {
+				return nil, err
+			}
+
+			parts = append(parts, &cssPart{combinator: combinator, simple: simple})
+			combinator = ' '
+			i = end
+		}
+	}
+
+	return parts, nil
+}
+
+func parseSimple(s string) (*cssSimple, error) {
+	simple := &cssSimple{}
+
+	ident := func(i int) int {
+		for i < len(s) && s[i] != '#' && s[i] != '.' && s[i] != '[' {
+			i++
+		}
+		return i
+	}
+
+	i := ident(0)
+	simple.tag = s[:i]
+
+	for i < len(s) {
+		switch s[i] {
+		case '#':
+			end := ident(i + 1)
+			simple.id = s[i+1 : end]
+			i = end
+		case '.':
+			end := ident(i + 1)
+			simple.classes = append(simple.classes, s[i+1:end])
+			i = end
+		case '[':
+			end, err := closing(s, i)
+			if err != nil {
+				return nil, err
+			}
+
+			simple.attrs = append(simple.attrs, parseCssAttr(s[i+1:end]))
+			i = end + 1
+		default:
+			return nil, fmt.Errorf("invalid css selector: %q", s)
+		}
+	}
+
+	return simple, nil
+}
+
+func parseCssAttr(s string) cssAttr {
+	for _, op := range []string{"*=", "^=", "$=", "~=", "="} {
+		if i := strings.Index(s, op); i > 0 {
+			return cssAttr{
+				name:  strings.TrimSpace(s[:i]),
+				op:    op,
+				value: unquote(s[i+len(op):]),
+			}
+		}
+	}
+
+	return cssAttr{name: strings.TrimSpace(s)}
+}
+
+func (c *cssSimple) match(n *Node) bool {
+	if !element(n) {
+		return false
+	}
+
+	if c.tag != "" && c.tag != "*" && !strings.EqualFold(c.tag, n.Tag) {
+		return false
+	}
+
+	if c.id != "" && n.Attrs["id"] != c.id {
+		return false
+	}
+
+	classes := strings.Fields(n.Attrs["class"])
+	for _, cls := range c.classes {
+		if !contains(classes, cls) {
+			return false
+		}
+	}
+
+	for _, a := range c.attrs {
+		v, ok := n.Attrs[a.name]
+		if !ok {
+			return false
+		}
+
+		switch a.op {
+		case "=":
+			ok = v == a.value
+		case "*=":
+			ok = strings.Contains(v, a.value)
+		case "^=":
+			ok = strings.HasPrefix(v, a.value)
+		case "$=":
+			ok = strings.HasSuffix(v, a.value)
+		case "~=":
+			ok = contains(strings.Fields(v), a.value)
+		}
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchCss
+// matches parts right to left
+func matchCss(n *Node, parts []*cssPart) bool {
+	last := parts[len(parts)-1]
+	if !last.simple.match(n) {
+		return false
+	}
+
+	if len(parts) == 1 {
+		return true
+	}
+
+	rest := parts[:len(parts)-1]
+	if last.combinator == '>' {
+		return n.Parent != nil && matchCss(n.Parent, rest)
+	}
+
+	for p := n.Parent; p != nil; p = p.Parent {
+		if matchCss(p, rest) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findXPath
+// evaluates xpath subset used by driver strategies:
+// unions, /, //, ., .., (expr)/.., node tests and predicates
+// with @attr, text(), contains(), starts-with(), normalize-space(),
+// and, or, not() and positions
+func findXPath(expr string, ctx *Node) ([]*Node, error) {
+	var nodes []*Node
+
+	for _, path := range splitTop(expr, '|') {
+		found, err := evalPath(strings.TrimSpace(path), ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, found...)
+	}
+
+	return documentOrder(nodes, ctx.root()), nil
+}
+
+// documentOrder
+// removes duplicates and sorts nodes
+func documentOrder(nodes []*Node, root *Node) []*Node {
+	order := map[*Node]int{}
+	i := 0
+	root.walk(func(n *Node) bool {
+		order[n] = i
+		i++
+		return true
+	})
+
+	seen := map[*Node]bool{}
+	var unique []*Node
+	for _, n := range nodes {
+		if !seen[n] {
+			seen[n] = true
+			unique = append(unique, n)
+		}
+	}
+
+	sort.SliceStable(unique, func(i, j int) bool {
+		return order[unique[i]] < order[unique[j]]
+	})
+
+	return unique
+}
+
+func evalPath(path string, ctx *Node) ([]*Node, error) {
+	if path == "" {
+		return nil, fmt.Errorf("invalid xpath: empty")
+	}
+
+	var nodes []*Node
+
+	switch {
+	case path[0] == '(':
+		end, err := closing(path, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes, err = findXPath(path[1:end], ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		path = path[end+1:]
+		for strings.HasPrefix(path, "[") {
+			end, err := closing(path, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			nodes, err = filter(nodes, path[1:end])
+			if err != nil {
+				return nil, err
+			}
+
+			path = path[end+1:]
+		}
+	case path[0] == '/':
+		nodes = []*Node{ctx.root()}
+	default:
+		nodes = []*Node{ctx}
+		if !strings.HasPrefix(path, ".") {
+			path = "/" + path
+		}
+	}
+
+	for path != "" {
+		descendant := false
+		switch {
+		case strings.HasPrefix(path, "//"):
+			descendant = true
+			path = path[2:]
+		case strings.HasPrefix(path, "/"):
+			path = path[1:]
+		}
+
+		step := splitTop(path, '/')[0]
+		path = path[len(step):]
+
+		var next []*Node
+		for _, n := range nodes {
+			found, err := evalStep(n, step, descendant)
+			if err != nil {
+				return nil, err
+			}
+
+			next = append(next, found...)
+		}
+
+		nodes = documentOrder(next, ctx.root())
+	}
+
+	return nodes, nil
+}
+
+func evalStep(n *Node, step string, descendant bool) ([]*Node, error) {
+	switch step {
+	case ".":
+		if descendant {
+			return append([]*Node{n}, n.descendants()...), nil
+		}
+		return []*Node{n}, nil
+	case "..":
+		if n.Parent == nil {
+			return nil, nil
+		}
+		return []*Node{n.Parent}, nil
+	}
+
+	name := step
+	var preds []string
+
+	if i := strings.IndexByte(step, '['); i >= 0 {
+		name = step[:i]
+		rest := step[i:]
+
+		for strings.HasPrefix(rest, "[") {
+			end, err := closing(rest, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			preds = append(preds, rest[1:end])
+			rest = rest[end+1:]
+		}
+	}
+
+	candidates := n.Children
+	if descendant {
+		candidates = n.descendants()
+	}
+
+	var nodes []*Node
+	for _, c := range candidates {
+		if element(c) && (name == "*" || strings.EqualFold(name, c.Tag)) {
+			nodes = append(nodes, c)
+		}
+	}
+
+	var err error
+	for _, p := range preds {
+		nodes, err = filter(nodes, p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return nodes, nil
+}
+
+func filter(nodes []*Node, pred string) ([]*Node, error) {
+	var matched []*Node
+
+	for i, n := range nodes {
+		ok, err := predicate(strings.TrimSpace(pred), n, i+1, len(nodes))
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			matched = append(matched, n)
+		}
+	}
+
+	return matched, nil
+}
+
+// splitWord
+// splits predicate by " and ", " or " outside of brackets and quotes
+func splitWord(s, word string) []string {
+	var parts []string
+	var depth int
+	var quote byte
+	start := 0
+
+	for i := 0; i+len(word) <= len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[' || c == '(':
+			depth++
+		case c == ']' || c == ')':
+			depth--
+		case depth == 0 && s[i:i+len(word)] == word:
+			parts = append(parts, s[start:i])
+			start = i + len(word)
+			i += len(word) - 1
+		}
+	}
+
+	return append(parts, s[start:])
+}
+
+func predicate(pred string, n *Node, pos, size int) (bool, error) {
+	if or := splitWord(pred, " or "); len(or) > 1 {
+		for _, p := range or {
+			ok, err := predicate(strings.TrimSpace(p), n, pos, size)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+
+		return false, nil
+	}
+
+	if and := splitWord(pred, " and "); len(and) > 1 {
+		for _, p := range and {
+			ok, err := predicate(strings.TrimSpace(p), n, pos, size)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+
+		return true, nil
+	}
+
+	if k, err := strconv.Atoi(pred); err == nil {
+		return pos == k, nil
+	}
+
+	if pred == "last()" {
+		return pos == size, nil
+	}
+
+	if strings.HasPrefix(pred, "not(") && strings.HasSuffix(pred, ")") {
+		ok, err := predicate(pred[4:len(pred)-1], n, pos, size)
+		return !ok, err
+	}
+
+	for _, fn := range []string{"contains(", "starts-with("} {
+		if strings.HasPrefix(pred, fn) && strings.HasSuffix(pred, ")") {
+			args := splitTop(pred[len(fn):len(pred)-1], ',')
+			if len(args) != 2 {
+				return false, fmt.Errorf("invalid xpath predicate: %q", pred)
+			}
+
+			v, sub := value(args[0], n), value(args[1], n)
+			if fn == "contains(" {
+				return strings.Contains(v, sub), nil
+			}
+			return strings.HasPrefix(v, sub), nil
+		}
+	}
+
+	for _, op := range []string{"!=", "="} {
+		if parts := splitTop(pred, op[0]); len(parts) == 2 {
+			lhs, rhs := parts[0], parts[1]
+			if op == "!=" {
+				rhs = strings.TrimPrefix(rhs, "=")
+			}
+
+			if strings.HasPrefix(strings.TrimSpace(lhs), "@") {
+				if _, ok := n.Attrs[strings.TrimSpace(lhs)[1:]]; !ok {
+					return false, nil
+				}
+			}
+
+			eq := value(lhs, n) == value(rhs, n)
+			if op == "!=" {
+				return !eq, nil
+			}
+			return eq, nil
+		}
+	}
+
+	if strings.HasPrefix(pred, "@") {
+		_, ok := n.Attrs[pred[1:]]
+		return ok, nil
+	}
+
+	if pred == "text()" {
+		return n.Text != "", nil
+	}
+
+	return false, fmt.Errorf("unsupported xpath predicate: %q", pred)
+}
+
+// value
+// string value of predicate operand
+func value(arg string, n *Node) string {
+	arg = strings.TrimSpace(arg)
+
+	switch {
+	case strings.HasPrefix(arg, "'") || strings.HasPrefix(arg, "\""):
+		return unquote(arg)
+	case strings.HasPrefix(arg, "@"):
+		return n.Attrs[arg[1:]]
+	case arg == "text()":
+		return n.Text
+	case arg == ".":
+		return n.TextContent()
+	case arg == "name()" || arg == "local-name()":
+		return n.Tag
+	case arg == "normalize-space()" || arg == "normalize-space(.)":
+		return strings.Join(strings.Fields(n.TextContent()), " ")
+	case strings.HasPrefix(arg, "normalize-space(") && strings.HasSuffix(arg, ")"):
+		return strings.Join(strings.Fields(value(arg[len("normalize-space("):len(arg)-1], n)), " ")
+	}
+
+	return arg
+}
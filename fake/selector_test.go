@@ -0,0 +1,99 @@
+package fake
+
+import (
+	"testing"
+
+	"github.com/mcsymiv/gost/data"
+	"github.com/mcsymiv/gost/driver"
+)
+
+func page() *Document {
+	return NewDocument("https://fake.test",
+		El("form", Attr("id", "login"), Child(
+			El("input", Attr("id", "user"), Attr("placeholder", "Work email"), Attr("class", "field wide")),
+			El("input", Attr("id", "pass"), Attr("type", "password")),
+			El("button", Attr("aria-label", "Sign in"), Text("LOG IN")),
+		)),
+		El("ul", Child(
+			El("li", Text("one")),
+			El("li", Text("two"), Hidden()),
+			El("li", Child(El("a", Attr("href", "https://fake.test/next"), Text("Next page")))),
+		)),
+	)
+}
+
+func TestFindSelectors(t *testing.T) {
+	doc := page()
+
+	cases := []struct {
+		selector *data.Selector
+		want     []string
+	}{
+		{driver.Strategy("#user"), []string{"input#user"}},
+		{driver.Strategy("[type='password']"), []string{"input#pass"}},
+		{driver.Strategy("//*[@id='login']"), []string{"form#login"}},
+		{driver.Strategy("LOG IN"), []string{"button"}},
+		{driver.Strategy("Work email"), []string{"input#user"}},
+		{driver.Strategy("Sign in"), []string{"button"}},
+		{driver.Strategy("//li[contains(text(),'tw')]"), []string{"li"}},
+		{driver.Strategy("//ul/li[last()]/a"), []string{"a"}},
+		{driver.ParentXpathStrategy(driver.Strategy("//*[@id='pass']")), []string{"form#login"}},
+		{driver.PXpathStrategy(2, driver.Strategy("//a")), []string{"ul"}},
+		{driver.Css("form > input.field"), []string{"input#user"}},
+		{driver.Css("form button, ul a"), []string{"button", "a"}},
+		{&data.Selector{Using: data.ByLinkText, Value: "Next page"}, []string{"a"}},
+		{&data.Selector{Using: data.ByTagName, Value: "li"}, []string{"li", "li", "li"}},
+	}
+
+	for _, c := range cases {
+		nodes, err := find(c.selector.Using, c.selector.Value, doc.Root)
+		if err != nil {
+			t.Errorf("%q: %v", c.selector.Value, err)
+			continue
+		}
+
+		var got []string
+		for _, n := range nodes {
+			name := n.Tag
+			if id := n.Attrs["id"]; id != "" {
+				name += "#" + id
+			}
+			got = append(got, name)
+		}
+
+		if len(got) != len(c.want) {
+			t.Errorf("%q: got %v, want %v", c.selector.Value, got, c.want)
+			continue
+		}
+
+		for i := range got {
+			if got[i] != c.want[i] {
+				t.Errorf("%q: got %v, want %v", c.selector.Value, got, c.want)
+				break
+			}
+		}
+	}
+}
+
+func TestFindFromElement(t *testing.T) {
+	doc := page()
+	form := doc.Find("#login")
+
+	nodes, err := find(data.ByXPath, driver.NextStrategy("//input").Value, form)
+	if err != nil || len(nodes) != 2 {
+		t.Fatalf("expected 2 inputs in form, got: %d, %v", len(nodes), err)
+	}
+
+	nodes, _ = find(data.ByCssSelector, "li", form)
+	if len(nodes) != 0 {
+		t.Errorf("expected no li in form, got: %d", len(nodes))
+	}
+}
+
+func TestTextContent(t *testing.T) {
+	doc := page()
+
+	if txt := doc.Find("ul").TextContent(); txt != "one Next page" {
+		t.Errorf("unexpected text: %q", txt)
+	}
+}
@@ -0,0 +1,998 @@
+package fake
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/mcsymiv/gost/client"
+	"github.com/mcsymiv/gost/config"
+	"github.com/mcsymiv/gost/data"
+)
+
+// blankUrl
+// initial url of new window
+const blankUrl = "about:blank"
+
+// Server
+// in-memory W3C WebDriver
+// serves endpoints used by client.WebClient
+// over fake DOM documents
+type Server struct {
+	*httptest.Server
+
+	// PlatformName
+	// returned in new session capabilities
+	// "linux" default value
+	PlatformName string
+
+	// NoWheel
+	// rejects wheel input source actions
+	// as drivers without wheel support
+	NoWheel bool
+
+	mu       sync.Mutex
+	sessions map[string]*Session
+	pages    map[string]*Document
+	scripts  []scriptHandler
+	commands []string
+
+	// after
+	// hooks run after command by "METHOD /endpoint/{id}"
+	after map[string][]func(s *Session)
+
+	// files
+	// uploaded with /se/file by driver host path
+	files map[string][]byte
+}
+
+// ScriptFunc
+// fake execute script handler
+// element references in args are resolved to *Node
+// returned *Node, []*Node are sent as element references
+type ScriptFunc func(s *Session, script string, args []interface{}) (interface{}, error)
+
+type scriptHandler struct {
+	match string
+	fn    ScriptFunc
+}
+
+// Session
+// fake WebDriver session state
+type Session struct {
+	Id          string
+	BrowserName string
+
+	// UnhandledPromptBehavior
+	// from new session capabilities
+	// "dismiss and notify" if empty
+	UnhandledPromptBehavior string
+
+	// Active
+	// focused element, body if nil
+	Active *Node
+
+	// Actions
+	// performed actions input sources
+	Actions [][]map[string]interface{}
+
+	// Pressed
+	// keys pressed with Control, Alt or Meta,
+	// i.e. "Control+Shift+k"
+	Pressed []string
+
+	// Cookies
+	// cookies added to session, any domain
+	Cookies []*data.Cookie
+
+	server   *Server
+	windows  []*Window
+	current  *Window
+	elements map[string]*Node
+	ids      map[*Node]string
+	pointers map[string]*pointer
+
+	// modifiers
+	// pressed modifier keys in order
+	modifiers []string
+}
+
+// Window
+// top-level browsing context
+type Window struct {
+	Handle string
+	Type   string
+	Doc    *Document
+
+	// Rect
+	// window position and size
+	// State is "normal", "maximized", "minimized" or "fullscreen"
+	Rect  data.Rect
+	State string
+
+	// history
+	// visited documents, Doc is history[pos]
+	history []*Document
+	pos     int
+
+	// frames
+	// entered iframe documents, innermost last
+	frames []*Document
+
+	// dialog
+	// opened alert, confirm or prompt
+	dialog *Dialog
+}
+
+// load
+// opens document in window
+// drops forward history
+func (win *Window) load(doc *Document) {
+	if len(win.history) == 0 {
+		win.history = []*Document{win.Doc}
+	}
+
+	win.history = append(win.history[:win.pos+1], doc)
+	win.pos = len(win.history) - 1
+	win.Doc = doc
+	win.frames = nil
+}
+
+// move
+// traverses history by delta
+// stays on current document at history bounds
+func (win *Window) move(delta int) {
+	pos := win.pos + delta
+	if pos < 0 || pos >= len(win.history) {
+		return
+	}
+
+	win.pos = pos
+	win.Doc = win.history[pos]
+	win.frames = nil
+}
+
+// Error
+// W3C error reply of fake handlers
+type Error struct {
+	Status  int
+	Code    string
+	Message string
+
+	// Data
+	// additional error data
+	// i.e. {"text": ""} of unexpected alert open
+	Data map[string]interface{}
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// NewError
+// builds W3C error with status code of error code
+func NewError(code, message string) *Error {
+	status := http.StatusInternalServerError
+
+	switch code {
+	case "no such element", "no such window", "no such frame", "no such alert", "no such cookie",
+		"no such shadow root", "stale element reference", "detached shadow root",
+		"invalid session id", "unknown command":
+		status = http.StatusNotFound
+	case "element click intercepted", "element not interactable", "insecure certificate",
+		"invalid argument", "invalid cookie domain", "invalid element state", "invalid selector":
+		status = http.StatusBadRequest
+	case "unknown method":
+		status = http.StatusMethodNotAllowed
+	}
+
+	return &Error{
+		Status:  status,
+		Code:    code,
+		Message: message,
+	}
+}
+
+type handler func(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error)
+
+// NewServer
+// starts fake WebDriver on local httptest server
+func NewServer() *Server {
+	srv := &Server{
+		PlatformName: "linux",
+		sessions:     map[string]*Session{},
+		pages:        map[string]*Document{},
+		files:        map[string][]byte{},
+		after:        map[string][]func(s *Session){},
+	}
+
+	srv.Server = httptest.NewServer(srv.routes())
+
+	return srv
+}
+
+func (srv *Server) routes() http.Handler {
+	sm := http.NewServeMux()
+
+	sm.HandleFunc("GET /status", srv.status)
+	sm.HandleFunc("POST /session", srv.newSession)
+	sm.Handle("DELETE /session/{sessionId}", srv.detached(deleteSession))
+
+	sm.Handle("POST /session/{sessionId}/url", srv.handle(navigate))
+	sm.Handle("GET /session/{sessionId}/url", srv.handle(currentUrl))
+	sm.Handle("POST /session/{sessionId}/back", srv.handle(back))
+	sm.Handle("POST /session/{sessionId}/forward", srv.handle(forward))
+	sm.Handle("POST /session/{sessionId}/refresh", srv.handle(refresh))
+	sm.Handle("GET /session/{sessionId}/title", srv.handle(title))
+	sm.Handle("GET /session/{sessionId}/screenshot", srv.handle(screenshot))
+	sm.Handle("POST /session/{sessionId}/se/file", srv.handle(uploadFile))
+
+	sm.Handle("POST /session/{sessionId}/element", srv.handle(findElement))
+	sm.Handle("POST /session/{sessionId}/elements", srv.handle(findElements))
+	sm.Handle("POST /session/{sessionId}/element/{elementId}/element", srv.handle(findElement))
+	sm.Handle("POST /session/{sessionId}/element/{elementId}/elements", srv.handle(findElements))
+	sm.Handle("GET /session/{sessionId}/element/active", srv.handle(activeElement))
+	sm.Handle("GET /session/{sessionId}/element/{elementId}/shadow", srv.handle(elementShadowRoot))
+	sm.Handle("POST /session/{sessionId}/shadow/{shadowId}/element", srv.handle(findElement))
+	sm.Handle("POST /session/{sessionId}/shadow/{shadowId}/elements", srv.handle(findElements))
+
+	sm.Handle("POST /session/{sessionId}/element/{elementId}/click", srv.handle(click))
+	sm.Handle("POST /session/{sessionId}/element/{elementId}/value", srv.handle(sendKeys))
+	sm.Handle("POST /session/{sessionId}/element/{elementId}/clear", srv.handle(clear))
+	sm.Handle("GET /session/{sessionId}/element/{elementId}/text", srv.handle(text))
+	sm.Handle("GET /session/{sessionId}/element/{elementId}/attribute/{name}", srv.handle(attribute))
+	sm.Handle("GET /session/{sessionId}/element/{elementId}/displayed", srv.handle(displayed))
+	sm.Handle("GET /session/{sessionId}/element/{elementId}/property/{name}", srv.handle(property))
+	sm.Handle("GET /session/{sessionId}/element/{elementId}/css/{name}", srv.handle(cssValue))
+	sm.Handle("GET /session/{sessionId}/element/{elementId}/name", srv.handle(tagName))
+	sm.Handle("GET /session/{sessionId}/element/{elementId}/rect", srv.handle(elementRect))
+	sm.Handle("GET /session/{sessionId}/element/{elementId}/enabled", srv.handle(enabled))
+	sm.Handle("GET /session/{sessionId}/element/{elementId}/selected", srv.handle(selected))
+	sm.Handle("GET /session/{sessionId}/element/{elementId}/computedrole", srv.handle(computedRole))
+	sm.Handle("GET /session/{sessionId}/element/{elementId}/computedlabel", srv.handle(computedLabel))
+
+	sm.Handle("POST /session/{sessionId}/execute/sync", srv.handle(executeSync))
+	sm.Handle("POST /session/{sessionId}/execute/async", srv.handle(executeAsync))
+
+	sm.Handle("GET /session/{sessionId}/window", srv.handle(windowHandle))
+	sm.Handle("POST /session/{sessionId}/window", srv.detached(switchWindow))
+	sm.Handle("DELETE /session/{sessionId}/window", srv.handle(closeWindow))
+	sm.Handle("GET /session/{sessionId}/window/handles", srv.detached(windowHandles))
+	sm.Handle("POST /session/{sessionId}/window/new", srv.detached(openWindow))
+	sm.Handle("GET /session/{sessionId}/window/rect", srv.handle(windowRect))
+	sm.Handle("POST /session/{sessionId}/window/rect", srv.handle(setWindowRect))
+	sm.Handle("POST /session/{sessionId}/window/maximize", srv.handle(windowState("maximized")))
+	sm.Handle("POST /session/{sessionId}/window/minimize", srv.handle(windowState("minimized")))
+	sm.Handle("POST /session/{sessionId}/window/fullscreen", srv.handle(windowState("fullscreen")))
+
+	sm.Handle("POST /session/{sessionId}/frame", srv.handle(switchFrame))
+	sm.Handle("POST /session/{sessionId}/frame/parent", srv.handle(parentFrame))
+
+	sm.Handle("GET /session/{sessionId}/cookie", srv.handle(getCookies))
+	sm.Handle("POST /session/{sessionId}/cookie", srv.handle(addCookie))
+	sm.Handle("DELETE /session/{sessionId}/cookie", srv.handle(deleteCookies))
+	sm.Handle("GET /session/{sessionId}/cookie/{name}", srv.handle(getCookie))
+	sm.Handle("DELETE /session/{sessionId}/cookie/{name}", srv.handle(deleteCookie))
+
+	sm.Handle("GET /session/{sessionId}/alert/text", srv.handle(alertText))
+	sm.Handle("POST /session/{sessionId}/alert/text", srv.handle(sendAlertText))
+	sm.Handle("POST /session/{sessionId}/alert/accept", srv.handle(acceptAlert))
+	sm.Handle("POST /session/{sessionId}/alert/dismiss", srv.handle(dismissAlert))
+
+	sm.Handle("POST /session/{sessionId}/actions", srv.handle(performActions))
+	sm.Handle("DELETE /session/{sessionId}/actions", srv.handle(releaseActions))
+
+	sm.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		srv.mu.Lock()
+		defer srv.mu.Unlock()
+
+		srv.record(r)
+		reply(w, nil, NewError("unknown command", fmt.Sprintf("%s %s", r.Method, r.URL.Path)))
+	})
+
+	return sm
+}
+
+// Page
+// registers document served on navigation to url
+func (srv *Server) Page(url string, nodes ...*Node) *Document {
+	doc := NewDocument(url, nodes...)
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	srv.pages[url] = doc
+	return doc
+}
+
+// HandleScript
+// registers execute script handler
+// used for scripts containing match substring
+// empty match handles any script
+func (srv *Server) HandleScript(match string, fn ScriptFunc) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	srv.scripts = append(srv.scripts, scriptHandler{match: match, fn: fn})
+}
+
+// Update
+// runs fn while requests are blocked
+// used to change DOM during driver calls
+func (srv *Server) Update(fn func()) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	fn()
+}
+
+// After
+// runs fn after each handled command matching "METHOD /endpoint/{id}"
+// i.e. opens dialog between find and next command
+//
+//	srv.After("POST /session/{id}/element", func(s *fake.Session) {
+//		s.OpenDialog(&fake.Dialog{Type: "alert", Text: "Saved"})
+//	})
+func (srv *Server) After(command string, fn func(s *Session)) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	srv.after[command] = append(srv.after[command], fn)
+}
+
+// Commands
+// received commands as "METHOD /endpoint/{id}" templates
+func (srv *Server) Commands() []string {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	return append([]string(nil), srv.commands...)
+}
+
+// Count
+// number of received commands matching "METHOD /endpoint/{id}"
+func (srv *Server) Count(command string) int {
+	var n int
+	for _, c := range srv.Commands() {
+		if c == command {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Session
+// returns session by id, nil if none
+func (srv *Server) Session(id string) *Session {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	return srv.sessions[id]
+}
+
+func (srv *Server) record(r *http.Request) string {
+	command := fmt.Sprintf("%s %s", r.Method, client.Endpoint(r.URL.Path))
+	srv.commands = append(srv.commands, command)
+
+	return command
+}
+
+func (srv *Server) status(w http.ResponseWriter, r *http.Request) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	srv.record(r)
+	reply(w, map[string]interface{}{"ready": true, "message": "fake driver ready"}, nil)
+}
+
+func (srv *Server) newSession(w http.ResponseWriter, r *http.Request) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	srv.record(r)
+
+	caps := new(struct {
+		Capabilities struct {
+			AlwaysMatch map[string]interface{} `json:"alwaysMatch"`
+		} `json:"capabilities"`
+	})
+	if err := json.NewDecoder(r.Body).Decode(caps); err != nil {
+		reply(w, nil, NewError("invalid argument", err.Error()))
+		return
+	}
+
+	browser, _ := caps.Capabilities.AlwaysMatch["browserName"].(string)
+	if browser == "" {
+		browser = "firefox"
+	}
+
+	prompt, _ := caps.Capabilities.AlwaysMatch["unhandledPromptBehavior"].(string)
+
+	s := &Session{
+		Id:                      uuid(),
+		BrowserName:             browser,
+		UnhandledPromptBehavior: prompt,
+		server:                  srv,
+		elements:                map[string]*Node{},
+		ids:                     map[*Node]string{},
+	}
+
+	win := newWindow(srv.document(blankUrl), "tab")
+	s.windows = append(s.windows, win)
+	s.current = win
+
+	srv.sessions[s.Id] = s
+
+	returned := map[string]interface{}{}
+	for k, v := range caps.Capabilities.AlwaysMatch {
+		returned[k] = v
+	}
+	returned["browserName"] = browser
+	returned["browserVersion"] = "fake"
+	returned["platformName"] = srv.PlatformName
+
+	reply(w, map[string]interface{}{
+		"sessionId":    s.Id,
+		"capabilities": returned,
+	}, nil)
+}
+
+// handle
+// locks server, resolves session
+// and decodes request body for fake handler
+// replies no such window if current window is closed
+func (srv *Server) handle(h handler) http.Handler {
+	return srv.serve(h, false)
+}
+
+// detached
+// handles command without current window
+// i.e. switch window after close
+func (srv *Server) detached(h handler) http.Handler {
+	return srv.serve(h, true)
+}
+
+func (srv *Server) serve(h handler, detached bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		srv.mu.Lock()
+		defer srv.mu.Unlock()
+
+		command := srv.record(r)
+
+		s, ok := srv.sessions[r.PathValue("sessionId")]
+		if !ok {
+			reply(w, nil, NewError("invalid session id", r.PathValue("sessionId")))
+			return
+		}
+
+		if s.current == nil && !detached {
+			reply(w, nil, NewError("no such window", "current window is closed"))
+			return
+		}
+
+		// opened dialog is handled before command
+		// except user prompt commands
+		if !detached && !strings.Contains(r.URL.Path, "/alert/") {
+			if err := s.unhandledDialog(); err != nil {
+				reply(w, nil, err)
+				return
+			}
+		}
+
+		body := map[string]interface{}{}
+		if r.Method == http.MethodPost {
+			b, err := io.ReadAll(r.Body)
+			if err != nil {
+				reply(w, nil, NewError("invalid argument", err.Error()))
+				return
+			}
+
+			if len(bytes.TrimSpace(b)) > 0 {
+				if err := json.Unmarshal(b, &body); err != nil {
+					reply(w, nil, NewError("invalid argument", err.Error()))
+					return
+				}
+			}
+		}
+
+		v, err := h(s, r, body)
+		for _, fn := range srv.after[command] {
+			fn(s)
+		}
+
+		reply(w, v, err)
+	})
+}
+
+// reply
+// writes W3C {"value": v} response or error
+func reply(w http.ResponseWriter, v interface{}, err error) {
+	w.Header().Set(config.ContenType, config.ApplicationJson)
+
+	if err != nil {
+		e, ok := err.(*Error)
+		if !ok {
+			e = NewError("unknown error", err.Error())
+		}
+
+		value := map[string]interface{}{
+			"error":      e.Code,
+			"message":    e.Message,
+			"stacktrace": "",
+		}
+
+		if e.Data != nil {
+			value["data"] = e.Data
+		}
+
+		w.WriteHeader(e.Status)
+		json.NewEncoder(w).Encode(map[string]interface{}{"value": value})
+		return
+	}
+
+	json.NewEncoder(w).Encode(map[string]interface{}{"value": v})
+}
+
+// document
+// registered page or empty document
+func (srv *Server) document(url string) *Document {
+	if doc, ok := srv.pages[url]; ok {
+		return doc
+	}
+
+	return NewDocument(url)
+}
+
+// Document
+// current browsing context document
+// innermost entered frame or window document
+func (s *Session) Document() *Document {
+	if n := len(s.current.frames); n > 0 {
+		return s.current.frames[n-1]
+	}
+
+	return s.current.Doc
+}
+
+// Window
+// current window
+func (s *Session) Window() *Window {
+	return s.current
+}
+
+// Navigate
+// loads registered page in current window
+func (s *Session) Navigate(url string) {
+	s.current.load(s.server.document(url))
+	s.Active = nil
+}
+
+// ref
+// W3C element reference of node
+func (s *Session) ref(n *Node) map[string]string {
+	id, ok := s.ids[n]
+	if !ok {
+		id = uuid()
+		s.ids[n] = id
+		s.elements[id] = n
+	}
+
+	return map[string]string{config.WebElementIdentifier: id}
+}
+
+// element
+// resolves element id attached to current document
+func (s *Session) element(id string) (*Node, error) {
+	n, ok := s.elements[id]
+	if !ok {
+		return nil, NewError("no such element", fmt.Sprintf("unknown element id %s", id))
+	}
+
+	if !s.Document().contains(n) {
+		return nil, NewError("stale element reference", fmt.Sprintf("element %s is not attached to the page document", id))
+	}
+
+	return n, nil
+}
+
+// pathElement
+// resolves elementId path value
+func (s *Session) pathElement(r *http.Request) (*Node, error) {
+	return s.element(r.PathValue("elementId"))
+}
+
+// active
+// focused element or body
+func (s *Session) active() *Node {
+	if s.Active != nil && s.Document().contains(s.Active) {
+		return s.Active
+	}
+
+	return s.Document().Body()
+}
+
+func deleteSession(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	delete(s.server.sessions, s.Id)
+	return nil, nil
+}
+
+func navigate(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	url, ok := body["url"].(string)
+	if !ok {
+		return nil, NewError("invalid argument", "missing url")
+	}
+
+	s.Navigate(url)
+	return nil, nil
+}
+
+func currentUrl(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	return s.Document().URL, nil
+}
+
+func back(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	s.current.move(-1)
+	s.Active = nil
+	return nil, nil
+}
+
+func forward(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	s.current.move(1)
+	s.Active = nil
+	return nil, nil
+}
+
+// refresh
+// keeps current document
+// registered pages are not rebuilt on reload
+func refresh(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	s.Active = nil
+	return nil, nil
+}
+
+func title(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	return s.Document().Title, nil
+}
+
+func screenshot(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	return pixel, nil
+}
+
+// locate
+// finds nodes from document or path element
+func locate(s *Session, r *http.Request, body map[string]interface{}) ([]*Node, string, error) {
+	using, _ := body["using"].(string)
+	value, _ := body["value"].(string)
+
+	ctx := s.Document().Root
+	if r.PathValue("elementId") != "" {
+		n, err := s.pathElement(r)
+		if err != nil {
+			return nil, value, err
+		}
+		ctx = n
+	}
+
+	if r.PathValue("shadowId") != "" {
+		n, err := s.shadowRoot(r.PathValue("shadowId"))
+		if err != nil {
+			return nil, value, err
+		}
+		ctx = n
+	}
+
+	nodes, err := find(using, value, ctx)
+	if err != nil {
+		return nil, value, NewError("invalid selector", err.Error())
+	}
+
+	return nodes, value, nil
+}
+
+func findElement(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	nodes, value, err := locate(s, r, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nodes) == 0 {
+		return nil, NewError("no such element", fmt.Sprintf("Unable to locate element: %s", value))
+	}
+
+	return s.ref(nodes[0]), nil
+}
+
+func findElements(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	nodes, _, err := locate(s, r, body)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := []map[string]string{}
+	for _, n := range nodes {
+		refs = append(refs, s.ref(n))
+	}
+
+	return refs, nil
+}
+
+func activeElement(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	return s.ref(s.active()), nil
+}
+
+func click(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	n, err := s.pathElement(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if !n.Displayed() {
+		return nil, NewError("element not interactable", fmt.Sprintf("element <%s> is not displayed", n.Tag))
+	}
+
+	s.clickNode(n)
+
+	return nil, nil
+}
+
+// clickNode
+// focuses element and runs its click behavior
+// element click and pointer actions
+func (s *Session) clickNode(n *Node) {
+	s.Active = n
+	activate(n)
+
+	if n.OnClick != nil {
+		n.OnClick(s, n)
+	}
+
+	s.fire(n, "click")
+
+	if href, ok := n.Attrs["href"]; ok && n.Tag == "a" {
+		s.Navigate(href)
+	}
+}
+
+// typed
+// strips W3C special keys, i.e. driver.EnterKey
+func typed(text string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '\ue000' && r <= '\uf8ff' {
+			return -1
+		}
+		return r
+	}, text)
+}
+
+func sendKeys(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	n, err := s.pathElement(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if !n.Displayed() {
+		return nil, NewError("element not interactable", fmt.Sprintf("element <%s> is not displayed", n.Tag))
+	}
+
+	text, ok := body["text"].(string)
+	if !ok {
+		return nil, NewError("invalid argument", "missing text")
+	}
+
+	s.Active = n
+
+	if n.Tag == "input" && n.Attrs["type"] == "file" {
+		return nil, s.setFiles(n, text)
+	}
+
+	s.sendText(n, text)
+
+	return nil, nil
+}
+
+func text(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	n, err := s.pathElement(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.TextContent(), nil
+}
+
+func attribute(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	n, err := s.pathElement(r)
+	if err != nil {
+		return nil, err
+	}
+
+	v, ok := n.Attrs[r.PathValue("name")]
+	if !ok {
+		return nil, nil
+	}
+
+	return v, nil
+}
+
+func displayed(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	n, err := s.pathElement(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.Displayed(), nil
+}
+
+// fromJSON
+// resolves element references in script arguments
+func (s *Session) fromJSON(v interface{}) (interface{}, error) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		if id, ok := t[config.WebElementIdentifier].(string); ok {
+			return s.element(id)
+		}
+
+		m := map[string]interface{}{}
+		for k, e := range t {
+			r, err := s.fromJSON(e)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = r
+		}
+		return m, nil
+	case []interface{}:
+		l := make([]interface{}, 0, len(t))
+		for _, e := range t {
+			r, err := s.fromJSON(e)
+			if err != nil {
+				return nil, err
+			}
+			l = append(l, r)
+		}
+		return l, nil
+	}
+
+	return v, nil
+}
+
+// toJSON
+// converts nodes in script result to element references
+func (s *Session) toJSON(v interface{}) interface{} {
+	switch t := v.(type) {
+	case *Node:
+		return s.ref(t)
+	case []*Node:
+		refs := []interface{}{}
+		for _, n := range t {
+			refs = append(refs, s.ref(n))
+		}
+		return refs
+	case []interface{}:
+		l := make([]interface{}, 0, len(t))
+		for _, e := range t {
+			l = append(l, s.toJSON(e))
+		}
+		return l
+	case map[string]interface{}:
+		m := map[string]interface{}{}
+		for k, e := range t {
+			m[k] = s.toJSON(e)
+		}
+		return m
+	}
+
+	return v
+}
+
+// script
+// runs registered handler for script
+func (s *Session) script(body map[string]interface{}) (interface{}, error) {
+	script, _ := body["script"].(string)
+
+	raw, _ := body["args"].([]interface{})
+	args, err := s.fromJSON(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, h := range s.server.scripts {
+		if !strings.Contains(script, h.match) {
+			continue
+		}
+
+		v, err := h.fn(s, script, args.([]interface{}))
+		if err != nil {
+			if e, ok := err.(*Error); ok {
+				return nil, e
+			}
+			return nil, NewError("javascript error", err.Error())
+		}
+
+		return s.toJSON(v), nil
+	}
+
+	return nil, nil
+}
+
+func executeSync(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	return s.script(body)
+}
+
+// executeAsync
+// handler return value is passed to script callback
+func executeAsync(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	return s.script(body)
+}
+
+func windowHandle(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	return s.current.Handle, nil
+}
+
+func switchWindow(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	handle, _ := body["handle"].(string)
+
+	for _, win := range s.windows {
+		if win.Handle == handle {
+			s.current = win
+			s.current.frames = nil
+			s.Active = nil
+			return nil, nil
+		}
+	}
+
+	return nil, NewError("no such window", handle)
+}
+
+func windowHandles(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	handles := []string{}
+	for _, win := range s.windows {
+		handles = append(handles, win.Handle)
+	}
+
+	return handles, nil
+}
+
+func openWindow(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	typ, _ := body["type"].(string)
+	if typ != "window" {
+		typ = "tab"
+	}
+
+	win := newWindow(s.server.document(blankUrl), typ)
+	s.windows = append(s.windows, win)
+
+	return map[string]string{
+		"handle": win.Handle,
+		"type":   win.Type,
+	}, nil
+}
+
+// pixel
+// base64 encoded 1x1 png screenshot
+var pixel = func() string {
+	var b bytes.Buffer
+	png.Encode(&b, image.NewRGBA(image.Rect(0, 0, 1, 1)))
+	return base64.StdEncoding.EncodeToString(b.Bytes())
+}()
+
+// uuid
+// random v4 uuid, matches service session id pattern
+func uuid() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
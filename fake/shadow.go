@@ -0,0 +1,43 @@
+package fake
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mcsymiv/gost/config"
+)
+
+// shadowRef
+// W3C shadow root reference of node
+func (s *Session) shadowRef(n *Node) map[string]string {
+	ref := s.ref(n)
+	return map[string]string{config.ShadowRootIdentifier: ref[config.WebElementIdentifier]}
+}
+
+// shadowRoot
+// resolves shadow root id attached to current document
+func (s *Session) shadowRoot(id string) (*Node, error) {
+	n, ok := s.elements[id]
+	if !ok || n.Tag != "#shadow-root" {
+		return nil, NewError("no such shadow root", fmt.Sprintf("unknown shadow root id %s", id))
+	}
+
+	if !s.Document().contains(n) {
+		return nil, NewError("detached shadow root", fmt.Sprintf("shadow root %s is not attached to the page document", id))
+	}
+
+	return n, nil
+}
+
+func elementShadowRoot(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	n, err := s.pathElement(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if n.Shadow == nil {
+		return nil, NewError("no such shadow root", fmt.Sprintf("element %s has no shadow root", r.PathValue("elementId")))
+	}
+
+	return s.shadowRef(n.Shadow), nil
+}
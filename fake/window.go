@@ -0,0 +1,100 @@
+package fake
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mcsymiv/gost/data"
+)
+
+// screen
+// fake display size
+var screen = data.Rect{Width: 1920, Height: 1080}
+
+// newWindow
+// window of default size
+// with document loaded
+func newWindow(doc *Document, typ string) *Window {
+	return &Window{
+		Handle: uuid(),
+		Type:   typ,
+		Doc:    doc,
+		Rect:   data.Rect{X: 10, Y: 10, Width: 1280, Height: 800},
+		State:  "normal",
+	}
+}
+
+func closeWindow(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	handles := []string{}
+	windows := s.windows[:0]
+
+	for _, win := range s.windows {
+		if win == s.current {
+			continue
+		}
+
+		windows = append(windows, win)
+		handles = append(handles, win.Handle)
+	}
+
+	s.windows = windows
+	s.current = nil
+	s.Active = nil
+
+	return handles, nil
+}
+
+func windowRect(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	return s.current.Rect, nil
+}
+
+// setWindowRect
+// applies x, y, width, height set in body
+// restores normal window state
+func setWindowRect(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+	b, _ := json.Marshal(body)
+
+	rect := new(struct {
+		X, Y          *float64
+		Width, Height *float64
+	})
+	if err := json.Unmarshal(b, rect); err != nil {
+		return nil, NewError("invalid argument", err.Error())
+	}
+
+	win := s.current
+	if rect.X != nil {
+		win.Rect.X = *rect.X
+	}
+	if rect.Y != nil {
+		win.Rect.Y = *rect.Y
+	}
+	if rect.Width != nil {
+		win.Rect.Width = *rect.Width
+	}
+	if rect.Height != nil {
+		win.Rect.Height = *rect.Height
+	}
+
+	win.State = "normal"
+
+	return win.Rect, nil
+}
+
+// windowState
+// maximize, minimize, fullscreen handler
+func windowState(state string) handler {
+	return func(s *Session, r *http.Request, body map[string]interface{}) (interface{}, error) {
+		win := s.current
+		win.State = state
+
+		switch state {
+		case "maximized", "fullscreen":
+			win.Rect = screen
+		case "minimized":
+			return data.Rect{X: win.Rect.X, Y: win.Rect.Y}, nil
+		}
+
+		return win.Rect, nil
+	}
+}
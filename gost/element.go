@@ -0,0 +1,176 @@
+package gost
+
+import (
+	"fmt"
+
+	"github.com/mcsymiv/gost/data"
+	"github.com/mcsymiv/gost/driver"
+)
+
+// element
+// finds element for element state steps
+// nil if element is not found
+func (s *Step) element(selector string) *driver.WebElement {
+	var el *driver.WebElement
+	err := s.retry(func() (err error) {
+		el, err = s.WD.FindElementE(driver.Strategy(selector))
+		if err != nil {
+			s.screenshot(err)
+			return fmt.Errorf("error on find element: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		s.TK.Errorf("%v", err)
+		return nil
+	}
+
+	return el
+}
+
+// do
+// runs element step action or state query,
+// sends it once again if unexpected alert was dismissed
+// reports failure
+func (s *Step) do(f func() error) {
+	err := s.retry(f)
+	if err != nil {
+		s.screenshot(err)
+		s.TK.Errorf("%v", err)
+	}
+}
+
+// Property
+// returns element DOM property
+func (s *Step) Property(selector, name string) interface{} {
+	el := s.element(selector)
+	if el == nil {
+		return nil
+	}
+
+	var v interface{}
+	s.do(func() (err error) {
+		v, err = el.PropertyE(name)
+		return err
+	})
+
+	return v
+}
+
+// CSSValue
+// returns computed css property value
+func (s *Step) CSSValue(selector, name string) string {
+	el := s.element(selector)
+	if el == nil {
+		return ""
+	}
+
+	var v string
+	s.do(func() (err error) {
+		v, err = el.CSSValueE(name)
+		return err
+	})
+
+	return v
+}
+
+func (s *Step) TagName(selector string) string {
+	el := s.element(selector)
+	if el == nil {
+		return ""
+	}
+
+	var tag string
+	s.do(func() (err error) {
+		tag, err = el.TagNameE()
+		return err
+	})
+
+	return tag
+}
+
+// Rect
+// returns element position and size
+func (s *Step) Rect(selector string) *data.Rect {
+	el := s.element(selector)
+	if el == nil {
+		return nil
+	}
+
+	var rect *data.Rect
+	s.do(func() (err error) {
+		rect, err = el.RectE()
+		return err
+	})
+
+	return rect
+}
+
+func (s *Step) IsEnabled(selector string) bool {
+	el := s.element(selector)
+	if el == nil {
+		return false
+	}
+
+	var ok bool
+	s.do(func() (err error) {
+		ok, err = el.IsEnabledE()
+		return err
+	})
+
+	return ok
+}
+
+// IsSelected
+// checkbox, radio is checked
+// or option is selected
+func (s *Step) IsSelected(selector string) bool {
+	el := s.element(selector)
+	if el == nil {
+		return false
+	}
+
+	var ok bool
+	s.do(func() (err error) {
+		ok, err = el.IsSelectedE()
+		return err
+	})
+
+	return ok
+}
+
+// ComputedRole
+// returns element accessibility role
+func (s *Step) ComputedRole(selector string) string {
+	el := s.element(selector)
+	if el == nil {
+		return ""
+	}
+
+	var role string
+	s.do(func() (err error) {
+		role, err = el.ComputedRoleE()
+		return err
+	})
+
+	return role
+}
+
+// ComputedLabel
+// returns element accessible name
+func (s *Step) ComputedLabel(selector string) string {
+	el := s.element(selector)
+	if el == nil {
+		return ""
+	}
+
+	var label string
+	s.do(func() (err error) {
+		label, err = el.ComputedLabelE()
+		return err
+	})
+
+	return label
+}
@@ -0,0 +1,85 @@
+package gost
+
+// SetText
+// clears element and types text
+func (s *Step) SetText(text, selector string) {
+	el := s.element(selector)
+	if el == nil {
+		return
+	}
+
+	s.do(func() error {
+		_, err := el.SetTextE(text)
+		return err
+	})
+}
+
+// Select
+// selects option of select element by visible text
+func (s *Step) Select(text, selector string) {
+	el := s.element(selector)
+	if el == nil {
+		return
+	}
+
+	s.do(func() error {
+		_, err := el.SelectByTextE(text)
+		return err
+	})
+}
+
+// Check
+// checks checkbox or radio if not checked
+func (s *Step) Check(selector string) {
+	el := s.element(selector)
+	if el == nil {
+		return
+	}
+
+	s.do(func() error {
+		_, err := el.CheckE()
+		return err
+	})
+}
+
+// Uncheck
+// unchecks checkbox if checked
+func (s *Step) Uncheck(selector string) {
+	el := s.element(selector)
+	if el == nil {
+		return
+	}
+
+	s.do(func() error {
+		_, err := el.UncheckE()
+		return err
+	})
+}
+
+// Submit
+// submits form containing element
+func (s *Step) Submit(selector string) {
+	el := s.element(selector)
+	if el == nil {
+		return
+	}
+
+	s.do(func() error {
+		_, err := el.SubmitE()
+		return err
+	})
+}
+
+// Upload
+// sets local files to file input
+func (s *Step) Upload(selector string, paths ...string) {
+	el := s.element(selector)
+	if el == nil {
+		return
+	}
+
+	s.do(func() error {
+		_, err := el.UploadE(paths...)
+		return err
+	})
+}
@@ -17,6 +17,7 @@ func Driver(capsFn ...capabilities.CapabilitiesFunc) (*driver.WebDriver, func())
 	return d, func() {
 		// teardown
 		d.Quit()
+		d.WebClient.CloseTrace()
 		command.OutFileLogs.Close()
 		d.Command.Process.Kill()
 	}
@@ -0,0 +1,62 @@
+package gost
+
+// Hover
+// moves mouse to element center
+func (s *Step) Hover(selector string) {
+	el := s.element(selector)
+	if el == nil {
+		return
+	}
+
+	s.do(func() error {
+		_, err := el.HoverE()
+		return err
+	})
+}
+
+// DoubleClick
+// double clicks on element
+func (s *Step) DoubleClick(selector string) {
+	el := s.element(selector)
+	if el == nil {
+		return
+	}
+
+	s.do(func() error {
+		_, err := el.DoubleClickE()
+		return err
+	})
+}
+
+// RightClick
+// right clicks on element
+func (s *Step) RightClick(selector string) {
+	el := s.element(selector)
+	if el == nil {
+		return
+	}
+
+	s.do(func() error {
+		_, err := el.RightClickE()
+		return err
+	})
+}
+
+// DragTo
+// drags element and drops it on target element
+func (s *Step) DragTo(selector, target string) {
+	el := s.element(selector)
+	if el == nil {
+		return
+	}
+
+	to := s.element(target)
+	if to == nil {
+		return
+	}
+
+	s.do(func() error {
+		_, err := el.DragToE(to)
+		return err
+	})
+}
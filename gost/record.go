@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/mcsymiv/gost/config"
+	"github.com/mcsymiv/gost/data"
 )
 
 // AutoGenerated
@@ -106,8 +107,16 @@ func convertSelectors(st AutoGeneratedSteps) []*GeneratedStepSelectors {
 			// 	genSelector.aria = aFormated
 			// }
 
-			// ignore chrome selectors type if specified in record
-			if strings.Contains(s[0], "pierce") {
+			// chrome records element in shadow DOM
+			// as host and inner css selectors array
+			// joined into driver pierce selector, i.e. "my-app >>> #submit"
+			if len(s) > 1 && !strings.Contains(s[0], "/") {
+				genSelector.pierce = strings.Join(s, fmt.Sprintf(" %s ", data.PierceSeparator))
+			}
+
+			// pierce/ selector is used
+			// if no shadow host chain is recorded
+			if strings.Contains(s[0], "pierce/") && genSelector.pierce == "" {
 				pFormated := strings.ReplaceAll(s[0], "pierce/", "")
 				genSelector.pierce = pFormated
 			}
@@ -0,0 +1,40 @@
+package gost
+
+import (
+	"fmt"
+
+	"github.com/mcsymiv/gost/driver"
+)
+
+// ScrollIntoView
+// scrolls element to the center of viewport
+func (s *Step) ScrollIntoView(selector string) {
+	el := s.element(selector)
+	if el == nil {
+		return
+	}
+
+	s.do(func() error {
+		_, err := el.ScrollIntoViewE(driver.BlockCenter)
+		return err
+	})
+}
+
+// ScrollUntil
+// scrolls page until selector is found
+// i.e. item of lazy loaded list
+func (s *Step) ScrollUntil(selector string, maxScrolls int) {
+	err := s.retry(func() error {
+		_, err := s.WD.ScrollUntilE(selector, maxScrolls)
+		if err != nil {
+			s.screenshot(err)
+			return fmt.Errorf("error on scroll until: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		s.TK.Errorf("%v", err)
+	}
+}
@@ -1,11 +1,13 @@
 package gost
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/mcsymiv/gost/capabilities"
+	"github.com/mcsymiv/gost/client"
 	"github.com/mcsymiv/gost/config"
 	"github.com/mcsymiv/gost/driver"
 )
@@ -15,6 +17,12 @@ type Step struct {
 	WD     *driver.WebDriver
 	Tear   func()
 	Config config.WebConfig
+
+	// DismissAlerts
+	// unexpected alert is logged and dismissed
+	// and failed step command is sent once again
+	// pair with capabilities.UnhandledPromptBehavior
+	DismissAlerts bool
 }
 
 func New(t *testing.T, capsFn ...capabilities.CapabilitiesFunc) *Step {
@@ -26,78 +34,116 @@ func New(t *testing.T, capsFn ...capabilities.CapabilitiesFunc) *Step {
 	}
 }
 
+// dismissed
+// logs and dismisses unexpected alert
+// if DismissAlerts is set
+// returns true if failed command can be sent again
+func (s *Step) dismissed(err error) bool {
+	if !s.DismissAlerts {
+		return false
+	}
+
+	text, ok := driver.UnexpectedAlert(err)
+	if !ok {
+		return false
+	}
+
+	s.TK.Logf("unexpected alert dismissed: %q", text)
+
+	// alert is already closed
+	// by "dismiss and notify", "accept and notify" behaviors
+	err = s.WD.DismissAlertE()
+	if err != nil && !errors.Is(err, client.ErrNoSuchAlert) {
+		s.TK.Errorf("%v", err)
+		return false
+	}
+
+	return true
+}
+
+// retry
+// runs f and sends it once again
+// if unexpected alert was dismissed
+func (s *Step) retry(f func() error) error {
+	err := f()
+	if s.dismissed(err) {
+		err = f()
+	}
+
+	return err
+}
+
+// screenshot
+// captures page of failed step
+// page can't be captured while alert is opened
+func (s *Step) screenshot(err error) {
+	if _, ok := driver.UnexpectedAlert(err); ok {
+		return
+	}
+
+	s.WD.Screenshot()
+}
+
 func (s *Step) Open(url string) {
-	open := func() error {
+	err := s.retry(func() error {
 		_, err := s.WD.WebClient.Open(url, s.WD.SessionId)
 		if err != nil {
-			s.WD.Screenshot()
-			return fmt.Errorf("error on open")
+			s.screenshot(err)
+			return fmt.Errorf("error on open: %w", err)
 		}
 
 		return nil
-	}
+	})
 
-	err := open()
 	if err != nil {
 		s.TK.Errorf("%v", err)
 	}
 }
 
 func (s *Step) Click(selector string) {
-	find := func() (*driver.WebElement, error) {
-		selector := driver.Strategy(selector)
-		eId, err := s.WD.WebClient.FindElement(selector, s.WD.SessionId)
+	var el *driver.WebElement
+	err := s.retry(func() (err error) {
+		el, err = s.WD.FindElementE(driver.Strategy(selector))
 		if err != nil {
-			s.WD.Screenshot()
-			return nil, err
+			s.screenshot(err)
 		}
 
-		return &driver.WebElement{
-			WebDriver:    s.WD,
-			WebElementId: eId,
-		}, nil
-	}
+		return err
+	})
 
-	el, err := find()
 	if err != nil {
 		s.TK.Error(err)
 	}
 
-	click := func() (*driver.WebElement, error) {
+	err = s.retry(func() error {
 		err := s.WD.WebClient.Click(s.WD.SessionId, el.WebElementId)
 		if err != nil {
-			s.WD.Screenshot()
-			return nil, fmt.Errorf("error on click: %v", err)
+			s.screenshot(err)
+			return fmt.Errorf("error on click: %w", err)
 		}
 
-		return el, nil
-	}
+		return nil
+	})
 
-	_, err = click()
 	if err != nil {
 		s.TK.Errorf("%v", err)
 	}
 }
 
 func (s *Step) TryClick(selectors ...string) {
-	find := func(sel string) (*driver.WebElement, error) {
-		selector := driver.Strategy(sel)
-		eId, err := s.WD.WebClient.FindElement(selector, s.WD.SessionId)
-		if err != nil {
-			s.WD.Screenshot()
-			return nil, fmt.Errorf("error on find element: %v", err)
-		}
-
-		return &driver.WebElement{
-			WebDriver:    s.WD,
-			WebElementId: eId,
-		}, nil
-	}
-
 	var el *driver.WebElement
 	var err error
 	for _, selector := range selectors {
-		el, err = find(selector)
+		err = s.retry(func() (err error) {
+			el, err = s.WD.FindElementE(driver.Strategy(selector))
+			if err != nil {
+				s.screenshot(err)
+				return fmt.Errorf("error on find element: %w", err)
+			}
+
+			return nil
+		})
+
 		if err != nil {
 			continue
 		}
@@ -109,17 +155,16 @@ func (s *Step) TryClick(selectors ...string) {
 		s.TK.Errorf("%v", err)
 	}
 
-	click := func() (*driver.WebElement, error) {
+	err = s.retry(func() error {
 		err := s.WD.WebClient.Click(s.WD.SessionId, el.WebElementId)
 		if err != nil {
-			s.WD.Screenshot()
-			return nil, fmt.Errorf("error on click: %v", err)
+			s.screenshot(err)
+			return fmt.Errorf("error on click: %w", err)
 		}
 
-		return el, nil
-	}
+		return nil
+	})
 
-	_, err = click()
 	if err != nil {
 		s.TK.Errorf("%v", err)
 	}
@@ -129,104 +174,88 @@ func (s *Step) TryClick(selectors ...string) {
 // Sends keys onto active element
 // after click
 func (s *Step) Input(text, selector string) {
-	find := func() (*driver.WebElement, error) {
-		selector := driver.Strategy(selector)
-		eId, err := s.WD.WebClient.FindElement(selector, s.WD.SessionId)
-		if err != nil {
-			s.WD.Screenshot()
-			return nil, fmt.Errorf("error on find element: %v", err)
-		}
-
-		return &driver.WebElement{
-			WebDriver:    s.WD,
-			WebElementId: eId,
-		}, nil
-	}
-
-	el, err := find()
-	if err != nil {
-		s.TK.Errorf("%v", err)
-	}
+	el := s.element(selector)
 
-	click := func() (*driver.WebElement, error) {
+	err := s.retry(func() error {
 		err := s.WD.WebClient.Click(s.WD.SessionId, el.WebElementId)
 		if err != nil {
-			s.WD.Screenshot()
-			return nil, fmt.Errorf("error on click: %v", err)
+			s.screenshot(err)
+			return fmt.Errorf("error on click: %w", err)
 		}
 
-		return el, nil
-	}
+		return nil
+	})
 
-	el, err = click()
 	if err != nil {
 		s.TK.Errorf("%v", err)
 	}
 
-	keys := func() (*driver.WebElement, error) {
+	err = s.retry(func() error {
 		err := s.WD.WebClient.Input(text, s.WD.SessionId, el.WebElementId)
 		if err != nil {
-			s.WD.Screenshot()
-			return nil, fmt.Errorf("error on keys: %v", err)
+			s.screenshot(err)
+			return fmt.Errorf("error on keys: %w", err)
 		}
 
-		return el, nil
-	}
+		return nil
+	})
 
-	_, err = keys()
 	if err != nil {
 		s.TK.Errorf("%v", err)
 	}
 }
 
+// Keys
+// sends keys to focused element
 func (s *Step) Keys(text string) {
-	action := func() error {
-		err := s.WD.WebClient.Action(text, string(driver.KeyDownAction), s.WD.SessionId)
+	err := s.retry(func() error {
+		err := s.WD.KeysE(text)
 		if err != nil {
-			s.WD.Screenshot()
-			return fmt.Errorf("error on find element: %v", err)
+			s.screenshot(err)
+			return fmt.Errorf("error on keys: %w", err)
 		}
 
 		return nil
-	}
+	})
 
-	err := action()
 	if err != nil {
 		s.TK.Errorf("%v", err)
 	}
 }
 
-func (s *Step) Is(selector string) bool {
-	find := func() (*driver.WebElement, error) {
-		selector := driver.Strategy(selector)
-		eId, err := s.WD.WebClient.FindElement(selector, s.WD.SessionId)
+// Press
+// presses shortcut on focused element
+// i.e. "Mod+A", "Shift+Tab"
+func (s *Step) Press(shortcut string) {
+	err := s.retry(func() error {
+		err := s.WD.PressE(shortcut)
 		if err != nil {
-			s.WD.Screenshot()
-			return nil, fmt.Errorf("error on find element: %v", err)
+			s.screenshot(err)
+			return fmt.Errorf("error on press: %w", err)
 		}
 
-		return &driver.WebElement{
-			WebDriver:    s.WD,
-			WebElementId: eId,
-		}, nil
-	}
+		return nil
+	})
 
-	el, err := find()
 	if err != nil {
 		s.TK.Errorf("%v", err)
 	}
+}
+
+func (s *Step) Is(selector string) bool {
+	el := s.element(selector)
 
-	is := func() (bool, error) {
-		ok, err := s.WD.WebClient.Is(el.WebElementId, s.WD.SessionId)
+	var ok bool
+	err := s.retry(func() (err error) {
+		ok, err = s.WD.WebClient.Is(s.WD.SessionId, el.WebElementId)
 		if err != nil {
-			s.WD.Screenshot()
-			return false, fmt.Errorf("error on find element: %v", err)
+			s.screenshot(err)
+			return fmt.Errorf("error on find element: %w", err)
 		}
 
-		return ok, nil
-	}
+		return nil
+	})
 
-	ok, err := is()
 	if err != nil {
 		s.TK.Errorf("%v", err)
 	}
@@ -234,21 +263,32 @@ func (s *Step) Is(selector string) bool {
 	return ok
 }
 
+// Until
+// polls fn until it returns true
+// with step WaitForTimeout, WaitForInterval
+// fails test on timeout
 func (s *Step) Until(fn func() bool) {
-	var success bool
-	start := time.Now()
-	end := start.Add(s.Config.WaitForTimeout * time.Second)
-
-	for {
-		success = fn()
-		if success {
-			break
-		}
-
-		if time.Now().After(end) {
-			panic("error on until")
-		}
+	err := s.WD.UntilE(fn,
+		driver.Timeout(s.Config.WaitForTimeout*time.Second),
+		driver.Interval(s.Config.WaitForInterval*time.Millisecond))
+	if err != nil {
+		s.screenshot(err)
+		s.TK.Fatalf("%v", err)
+	}
+}
 
-		time.Sleep(s.Config.WaitForInterval * time.Millisecond)
+// Wait
+// waits for condition
+// reports condition and last observed value on timeout
+//
+//	st.Wait(driver.And(driver.Visible("#save"), driver.Absent("#spinner")))
+func (s *Step) Wait(c driver.Condition, opts ...driver.WaitOption) bool {
+	err := s.WD.WaitE(c, opts...)
+	if err != nil {
+		s.screenshot(err)
+		s.TK.Errorf("%v", err)
+		return false
 	}
+
+	return true
 }
@@ -0,0 +1,204 @@
+package gost_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mcsymiv/gost/capabilities"
+	"github.com/mcsymiv/gost/config"
+	"github.com/mcsymiv/gost/fake"
+	"github.com/mcsymiv/gost/gost"
+)
+
+const home = "https://fake.test"
+
+func TestStep(t *testing.T) {
+	d, srv := fake.Gost(t)
+	doc := srv.Page(home,
+		fake.El("input", fake.Attr("id", "q"), fake.Attr("placeholder", "Search")),
+		fake.El("button", fake.Text("Go")),
+	)
+
+	st := &gost.Step{TK: t, WD: d, Config: *config.Config}
+
+	st.Open(home)
+	st.Input("hello", "Search")
+	st.Click("Go")
+
+	if v := doc.Find("#q").Attrs["value"]; v != "hello" {
+		t.Errorf("unexpected input value: %q", v)
+	}
+
+	if !st.Is("Go") {
+		t.Error("expected Go button to be displayed")
+	}
+}
+
+func TestStepDismissAlerts(t *testing.T) {
+	d, srv := fake.Gost(t, capabilities.UnhandledPromptBehavior(capabilities.PromptIgnore))
+	srv.Page(home,
+		fake.El("button", fake.Text("Delete"), fake.OnClick(func(s *fake.Session, n *fake.Node) {
+			s.OpenDialog(&fake.Dialog{Type: "alert", Text: "Deleted"})
+		})),
+		fake.El("button", fake.Text("Go")),
+	)
+
+	st := &gost.Step{TK: t, WD: d, Config: *config.Config, DismissAlerts: true}
+
+	st.Open(home)
+	st.Click("Delete")
+	st.Click("Go")
+
+	if s := srv.Session(d.SessionId); s.Dialog() != nil {
+		t.Errorf("expected alert to be dismissed, got: %+v", s.Dialog())
+	}
+}
+
+func TestStepElementState(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home,
+		fake.El("input", fake.Attr("id", "terms"), fake.Attr("type", "checkbox"), fake.Attr("checked", "")),
+		fake.El("button", fake.Attr("disabled", ""), fake.Text("Save"), fake.Rect(0, 0, 80, 30)),
+	)
+
+	st := &gost.Step{TK: t, WD: d, Config: *config.Config}
+	st.Open(home)
+
+	if !st.IsSelected("#terms") || st.ComputedRole("#terms") != "checkbox" {
+		t.Error("expected checked terms checkbox")
+	}
+
+	if st.IsEnabled("Save") || st.TagName("Save") != "button" || st.ComputedLabel("Save") != "Save" {
+		t.Error("expected disabled Save button")
+	}
+
+	if r := st.Rect("Save"); r == nil || r.Width != 80 {
+		t.Errorf("unexpected Save rect: %+v", r)
+	}
+}
+
+func TestStepForm(t *testing.T) {
+	d, srv := fake.Gost(t)
+	doc := srv.Page(home,
+		fake.El("input", fake.Attr("id", "q"), fake.Attr("value", "old")),
+		fake.El("select", fake.Attr("id", "lang"), fake.Child(
+			fake.El("option", fake.Attr("value", "en"), fake.Text("English")),
+			fake.El("option", fake.Attr("value", "uk"), fake.Text("Ukrainian")),
+		)),
+		fake.El("input", fake.Attr("id", "terms"), fake.Attr("type", "checkbox")),
+	)
+
+	st := &gost.Step{TK: t, WD: d, Config: *config.Config}
+	st.Open(home)
+
+	st.SetText("new", "#q")
+	st.Select("Ukrainian", "#lang")
+	st.Check("#terms")
+	st.Check("#terms")
+
+	if v := doc.Find("#q").Attrs["value"]; v != "new" {
+		t.Errorf("unexpected input value: %q", v)
+	}
+
+	if !st.IsSelected("//option[@value='uk']") || !st.IsSelected("#terms") {
+		t.Error("expected selected option and checked terms")
+	}
+}
+
+func TestStepMouse(t *testing.T) {
+	d, srv := fake.Gost(t)
+
+	var events []string
+	on := func(event string) fake.NodeOption {
+		return fake.On(event, func(s *fake.Session, n *fake.Node) {
+			events = append(events, n.Attrs["id"]+":"+event)
+		})
+	}
+
+	srv.Page(home,
+		fake.El("li", fake.Attr("id", "row"), on("mouseover"), on("dblclick"), on("contextmenu")),
+		fake.El("div", fake.Attr("id", "card"), on("mousedown")),
+		fake.El("div", fake.Attr("id", "done"), on("mouseup")),
+	)
+
+	st := &gost.Step{TK: t, WD: d, Config: *config.Config}
+	st.Open(home)
+
+	st.Hover("#row")
+	st.DoubleClick("#row")
+	st.RightClick("#row")
+	st.DragTo("#card", "#done")
+
+	want := "row:mouseover,row:dblclick,row:contextmenu,card:mousedown,done:mouseup"
+	if got := strings.Join(events, ","); got != want {
+		t.Errorf("unexpected events: %s", got)
+	}
+}
+
+func TestStepScrollUntil(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home,
+		fake.El("ul", fake.Rect(0, 0, 300, 600), fake.On("scroll", func(s *fake.Session, n *fake.Node) {
+			n.Append(fake.El("li", fake.Attr("id", "more"), fake.Text("More")))
+		})),
+	)
+
+	st := &gost.Step{TK: t, WD: d, Config: *config.Config}
+	st.Open(home)
+
+	st.ScrollUntil("#more", 3)
+	st.ScrollIntoView("#more")
+
+	if n := srv.Count("POST /session/{id}/actions"); n != 1 {
+		t.Errorf("expected single scroll, got: %d", n)
+	}
+}
+
+func TestStepUntilConfig(t *testing.T) {
+	d, _ := fake.Gost(t)
+
+	global := *config.Config
+	t.Cleanup(func() { *config.Config = global })
+
+	st := &gost.Step{TK: t, WD: d, Config: *config.Config}
+	st.Config.WaitForTimeout = 2
+	st.Config.WaitForInterval = 1
+	config.Config.WaitForTimeout = 0
+
+	var n int
+	st.Until(func() bool {
+		n++
+		return n == 3
+	})
+
+	if n != 3 {
+		t.Errorf("expected 3 polls, got: %d", n)
+	}
+}
+
+func TestStepDismissAlertsOnAction(t *testing.T) {
+	d, srv := fake.Gost(t, capabilities.UnhandledPromptBehavior(capabilities.PromptIgnore))
+	doc := srv.Page(home,
+		fake.El("input", fake.Attr("id", "name")),
+		fake.El("div", fake.Attr("id", "target"), fake.Rect(0, 0, 100, 100)),
+	)
+
+	// alert opens after each element lookup
+	srv.After("POST /session/{id}/element", func(s *fake.Session) {
+		s.OpenDialog(&fake.Dialog{Type: "alert", Text: "Session expires soon"})
+	})
+
+	st := &gost.Step{TK: t, WD: d, Config: *config.Config, DismissAlerts: true}
+
+	st.Open(home)
+	st.SetText("bob", "#name")
+	st.Hover("#target")
+
+	if tag := st.TagName("#target"); tag != "div" {
+		t.Errorf("unexpected tag name: %q", tag)
+	}
+
+	if v := doc.Find("#name").Attrs["value"]; v != "bob" {
+		t.Errorf("expected text set after dismissed alert, got: %q", v)
+	}
+}
@@ -0,0 +1,49 @@
+package gost
+
+import (
+	"time"
+
+	"github.com/mcsymiv/gost/driver"
+)
+
+// Tap
+// taps element with one finger
+func (s *Step) Tap(selector string) {
+	el := s.element(selector)
+	if el == nil {
+		return
+	}
+
+	s.do(func() error {
+		_, err := el.TapE()
+		return err
+	})
+}
+
+// LongPress
+// touches element for duration
+func (s *Step) LongPress(selector string, d time.Duration) {
+	el := s.element(selector)
+	if el == nil {
+		return
+	}
+
+	s.do(func() error {
+		_, err := el.LongPressE(d)
+		return err
+	})
+}
+
+// Swipe
+// moves finger from element center by distance
+func (s *Step) Swipe(selector string, direction driver.Direction, distance int) {
+	el := s.element(selector)
+	if el == nil {
+		return
+	}
+
+	s.do(func() error {
+		_, err := el.SwipeE(direction, distance)
+		return err
+	})
+}
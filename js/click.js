@@ -1,4 +1,3 @@
 return (function click(el) {
-  el[0].click();
-}).apply(null, arguments)
-
+  el.click();
+}).apply(null, arguments);
@@ -0,0 +1,3 @@
+return (function computedStyle(el, property) {
+  return window.getComputedStyle(el).getPropertyValue(property);
+}).apply(null, arguments);
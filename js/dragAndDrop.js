@@ -0,0 +1,23 @@
+return (function dragAndDrop(source, target) {
+  // HTML5 drag and drop events are not fired
+  // by synthetic pointer actions in some drivers
+  const data = new DataTransfer();
+  const fire = (el, type) => {
+    const rect = el.getBoundingClientRect();
+    const event = new DragEvent(type, {
+      bubbles: true,
+      cancelable: true,
+      composed: true,
+      dataTransfer: data,
+      clientX: rect.left + rect.width / 2,
+      clientY: rect.top + rect.height / 2,
+    });
+    el.dispatchEvent(event);
+  };
+
+  fire(source, 'dragstart');
+  fire(target, 'dragenter');
+  fire(target, 'dragover');
+  fire(target, 'drop');
+  fire(source, 'dragend');
+}).apply(null, arguments);
@@ -0,0 +1,4 @@
+return (function highlight(el, color) {
+  el.style.outline = `3px solid ${color || 'red'}`;
+  el.style.outlineOffset = '-3px';
+}).apply(null, arguments);
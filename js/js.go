@@ -0,0 +1,58 @@
+// Package js
+// bundled JavaScript helpers
+// executed by driver Script and Js methods
+package js
+
+import (
+	"embed"
+	"fmt"
+	"os"
+
+	"github.com/mcsymiv/gost/config"
+)
+
+//go:embed *.js
+var files embed.FS
+
+// Source
+// returns script of name.js
+// file found in config JsFilesPath overrides bundled script
+func Source(name string) (string, error) {
+	fName := fmt.Sprintf("%s.js", name)
+
+	if f := overlay(fName); f != "" {
+		c, err := os.ReadFile(f)
+		if err != nil {
+			return "", fmt.Errorf("error on read file: %v", err)
+		}
+
+		return string(c), nil
+	}
+
+	c, err := files.ReadFile(fName)
+	if err != nil {
+		return "", fmt.Errorf("error on find script %s: %w", fName, err)
+	}
+
+	return string(c), nil
+}
+
+// overlay
+// user script path in JsFilesPath
+// empty if directory or file is missing
+func overlay(fName string) string {
+	if config.Config == nil || config.Config.JsFilesPath == "" {
+		return ""
+	}
+
+	if _, err := os.Stat(config.Config.JsFilesPath); err != nil {
+		return ""
+	}
+
+	f, err := config.FindFile(config.Config.JsFilesPath, fName)
+	if err != nil {
+		return ""
+	}
+
+	return f
+}
@@ -0,0 +1,47 @@
+package js_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mcsymiv/gost/config"
+	"github.com/mcsymiv/gost/js"
+)
+
+func TestSource(t *testing.T) {
+	config.Config = config.DefaultConfig()
+	config.Config.JsFilesPath = filepath.Join(t.TempDir(), "missing")
+
+	for _, name := range []string{"click", "setValue", "scrollIntoView", "highlight", "computedStyle", "reload"} {
+		src, err := js.Source(name)
+		if err != nil || src == "" {
+			t.Errorf("expected bundled %s script, got: %q, %v", name, src, err)
+		}
+	}
+
+	if _, err := js.Source("missing"); err == nil {
+		t.Error("expected error on missing script")
+	}
+}
+
+func TestSourceOverlay(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "click.js"), []byte("return 'overlay';"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	config.Config = config.DefaultConfig()
+	config.Config.JsFilesPath = dir
+
+	src, err := js.Source("click")
+	if err != nil || src != "return 'overlay';" {
+		t.Errorf("expected overlay click script, got: %q, %v", src, err)
+	}
+
+	src, err = js.Source("highlight")
+	if err != nil || !strings.Contains(src, "function highlight") {
+		t.Errorf("expected bundled highlight script, got: %q, %v", src, err)
+	}
+}
@@ -0,0 +1,4 @@
+return (function scrollBy(el, x, y) {
+  // page is scrolled if no container element
+  (el || window).scrollBy(x, y);
+}).apply(null, arguments);
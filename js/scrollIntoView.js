@@ -0,0 +1,3 @@
+return (function scrollIntoView(el, block) {
+  el.scrollIntoView({ block: block || 'center', inline: 'nearest' });
+}).apply(null, arguments);
@@ -0,0 +1,7 @@
+return (function scrollPosition(el) {
+  if (el) {
+    return [el.scrollLeft, el.scrollTop];
+  }
+
+  return [window.scrollX, window.scrollY];
+}).apply(null, arguments);
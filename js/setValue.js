@@ -1,3 +1,13 @@
-return (function setValue(args) {
-  args[0].value=`${args[1]}`;
+return (function setValue(el, value) {
+  // native setter keeps framework controlled inputs in sync
+  const proto = Object.getPrototypeOf(el);
+  const desc = Object.getOwnPropertyDescriptor(proto, 'value');
+  if (desc && desc.set) {
+    desc.set.call(el, `${value}`);
+  } else {
+    el.value = `${value}`;
+  }
+
+  el.dispatchEvent(new Event('input', { bubbles: true }));
+  el.dispatchEvent(new Event('change', { bubbles: true }));
 }).apply(null, arguments);
@@ -0,0 +1,13 @@
+return (function submit(el) {
+  const form = el instanceof HTMLFormElement ? el : el.form || el.closest('form');
+  if (!form) {
+    throw new Error('element is not in a form');
+  }
+
+  // requestSubmit runs validation and submit handlers
+  if (form.requestSubmit) {
+    form.requestSubmit();
+  } else {
+    form.submit();
+  }
+}).apply(null, arguments);
@@ -42,11 +42,43 @@ func recoverer(next http.Handler) http.Handler {
 	return http.HandlerFunc(fn)
 }
 
-func (wd *WebDriverHandler) retrier(v verifier) http.Handler {
-	var res *http.Response
+// wait
+// returns request timeout and interval for retry loops
+// set by client in WaitTimeoutHeader, WaitIntervalHeader
+// defaults to WaitForTimeout, WaitForInterval
+func (wd *WebDriverHandler) wait(r *http.Request) (time.Duration, time.Duration) {
+	timeout := wd.conf.WaitForTimeout * time.Second
+	interval := wd.conf.WaitForInterval * time.Millisecond
+
+	if d, err := time.ParseDuration(r.Header.Get(config.WaitTimeoutHeader)); err == nil && d >= 0 {
+		timeout = d
+	}
+
+	if d, err := time.ParseDuration(r.Header.Get(config.WaitIntervalHeader)); err == nil && d > 0 {
+		interval = d
+	}
 
+	return timeout, interval
+}
+
+// sleep
+// pauses retry loop for interval
+// returns false if request is cancelled, i.e. client disconnected
+func sleep(r *http.Request, interval time.Duration) bool {
+	t := time.NewTimer(interval)
+	defer t.Stop()
+
+	select {
+	case <-r.Context().Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
+
+func (wd *WebDriverHandler) retrier(v verifier) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		url := fmt.Sprintf("%s%s", wd.conf.WebDriverAddr, r.URL.Path)
+		url := fmt.Sprintf("%s%s", wd.conf.WebDriverAddr, r.URL.EscapedPath())
 
 		var data []byte
 		var err error
@@ -54,91 +86,140 @@ func (wd *WebDriverHandler) retrier(v verifier) http.Handler {
 		if r.Body != http.NoBody {
 			data, err = io.ReadAll(r.Body)
 			if err != nil {
-				json.NewEncoder(w).Encode(fmt.Errorf("error on read post request body: %v", err))
+				writeError(w, fmt.Errorf("error on read post request body: %v", err))
+				return
 			}
 
 			r.Body = io.NopCloser(bytes.NewReader(data))
 		}
 
-		start := time.Now()
-		end := start.Add(wd.conf.WaitForTimeout * time.Second)
+		timeout, interval := wd.wait(r)
+		end := time.Now().Add(timeout)
+
+		// refresh once on wait timeout
+		// single attempt lookups, i.e. absence checks, are not refreshed
+		refresh := wd.conf.RefreshOnFindError && isFind(r) && timeout > 0
+
+		var res *http.Response
 
 		for {
-			req, err := http.NewRequest(r.Method, url, bytes.NewReader(data))
+			req, err := http.NewRequestWithContext(r.Context(), r.Method, url, bytes.NewReader(data))
 			if err != nil {
-				fmt.Println("error on NewRequest")
-				req.Body.Close()
-				panic(err)
+				writeError(w, fmt.Errorf("error on new request: %v", err))
+				return
 			}
 
+			// request is aborted when client cancels
 			res, err = wd.client.Do(req)
 			if err != nil {
-				fmt.Println("error on Client Do Request")
-				res.Body.Close()
-				panic(err)
+				writeError(w, fmt.Errorf("error on client do request: %v", err))
+				return
 			}
 
 			// strategy for strategy
 			// "verified" response will return true
 			// and break out of the loop
-			if v.verify(res) {
+			if v.verify(res) || alertOpen(res) {
 				break
 			}
 
-			if time.Now().After(end) {
+			if time.Now().After(end) && !refresh {
 				break
 			}
 
-			time.Sleep(wd.conf.WaitForInterval * time.Millisecond)
-			fmt.Println("retry find element")
-
 			// close res res.Body if not verified
 			// i.e. StrategyRequest returns false
 			res.Body.Close()
+
+			// final find after page refresh
+			if time.Now().After(end) {
+				refresh = false
+				wd.refresh(r)
+				continue
+			}
+
+			if !sleep(r, interval) {
+				log.Printf("retry cancelled %s %s: %v", r.Method, r.URL.Path, r.Context().Err())
+				return
+			}
 		}
 
+		defer res.Body.Close()
+
 		data, err = io.ReadAll(res.Body)
 		if err != nil {
-			json.NewEncoder(w).Encode(fmt.Errorf("error on get response: %v", err))
+			writeError(w, fmt.Errorf("error on get response: %v", err))
 			return
 		}
 
-		defer res.Body.Close()
-
-		w.Header().Set(config.ContenType, config.ApplicationJson)
-		w.Write(data)
+		writeResponse(w, res.StatusCode, data)
 	})
 }
 
-func (wd *WebDriverHandler) isRetrier(v verifier) http.Handler {
-	var ok struct{ Value bool }
-	var res *http.Response
+// isFind
+// find element(s) request from session
+// find from element or shadow root is not refreshed
+// as parent element is stale after refresh
+func isFind(r *http.Request) bool {
+	if r.PathValue("elementId") != "" || r.PathValue("shadowId") != "" {
+		return false
+	}
 
+	return strings.HasSuffix(r.URL.Path, "/element") || strings.HasSuffix(r.URL.Path, "/elements")
+}
+
+// refresh
+// reloads page before final find retry
+// on RefreshOnFindError
+func (wd *WebDriverHandler) refresh(r *http.Request) {
+	url := fmt.Sprintf("%s/session/%s/refresh", wd.conf.WebDriverAddr, r.PathValue("sessionId"))
+
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodPost, url, strings.NewReader("{}"))
+	if err != nil {
+		log.Printf("error on refresh request: %v", err)
+		return
+	}
+
+	req.Header.Set(config.ContenType, config.ApplicationJson)
+
+	res, err := wd.client.Do(req)
+	if err != nil {
+		log.Printf("error on refresh: %v", err)
+		return
+	}
+
+	res.Body.Close()
+}
+
+func (wd *WebDriverHandler) isRetrier(v verifier) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		url := fmt.Sprintf("%s%s", wd.conf.WebDriverAddr, r.URL.Path)
-		start := time.Now()
-		end := start.Add(wd.conf.WaitForTimeout * time.Second)
+		url := fmt.Sprintf("%s%s", wd.conf.WebDriverAddr, r.URL.EscapedPath())
+
+		timeout, interval := wd.wait(r)
+		end := time.Now().Add(timeout)
+
+		var ok struct{ Value bool }
+		var res *http.Response
 
 		for {
-			req, err := http.NewRequest(http.MethodGet, url, nil)
+			req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, url, nil)
 			if err != nil {
-				fmt.Println("error on NewRequest")
-				req.Body.Close()
-				panic(err)
+				writeError(w, fmt.Errorf("error on new request: %v", err))
+				return
 			}
 
+			// request is aborted when client cancels
 			res, err = wd.client.Do(req)
 			if err != nil {
-				fmt.Println("error on Client Do Request")
-				res.Body.Close()
-				panic(err)
+				writeError(w, fmt.Errorf("error on client do request: %v", err))
+				return
 			}
 
 			// strategy for strategy
 			// "verified" response will return true
 			// and break out of the loop
 			ok.Value = v.verify(res)
-			if ok.Value {
+			if ok.Value || alertOpen(res) {
 				break
 			}
 
@@ -146,24 +227,37 @@ func (wd *WebDriverHandler) isRetrier(v verifier) http.Handler {
 				break
 			}
 
-			time.Sleep(wd.conf.WaitForInterval * time.Millisecond)
-			fmt.Println("retry on displayed")
-
 			// close res res.Body if not verified
 			// i.e. StrategyRequest returns false
 			res.Body.Close()
+
+			if !sleep(r, interval) {
+				log.Printf("retry cancelled %s %s: %v", r.Method, r.URL.Path, r.Context().Err())
+				return
+			}
+		}
+
+		defer res.Body.Close()
+
+		// forward webdriver error, i.e. stale element reference
+		if res.StatusCode != http.StatusOK {
+			data, err := io.ReadAll(res.Body)
+			if err != nil {
+				writeError(w, fmt.Errorf("error on get response: %v", err))
+				return
+			}
+
+			writeResponse(w, res.StatusCode, data)
+			return
 		}
 
 		body, err := json.Marshal(ok)
 		if err != nil {
-			json.NewEncoder(w).Encode(fmt.Errorf("error on read post response: %v", err))
+			writeError(w, fmt.Errorf("error on read post response: %v", err))
 			return
 		}
 
-		defer res.Body.Close()
-
-		w.Header().Set(config.ContenType, config.ApplicationJson)
-		w.Write(body)
+		writeResponse(w, http.StatusOK, body)
 	})
 }
 
@@ -188,71 +282,3 @@ func (wd *WebDriverHandler) script(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r)
 	})
 }
-
-func (wd *WebDriverHandler) retrier2(v verifier) http.Handler {
-	var res *http.Response
-
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		url := fmt.Sprintf("%s%s", wd.conf.WebDriverAddr, r.URL.Path)
-
-		var data []byte
-		var err error
-
-		if r.Body != http.NoBody {
-			data, err = io.ReadAll(r.Body)
-			if err != nil {
-				json.NewEncoder(w).Encode(fmt.Errorf("error on read post request body: %v", err))
-			}
-
-			r.Body = io.NopCloser(bytes.NewReader(data))
-		}
-
-		start := time.Now()
-		end := start.Add(wd.conf.WaitForTimeout * time.Second)
-
-		for {
-			req, err := http.NewRequest(r.Method, url, bytes.NewReader(data))
-			if err != nil {
-				fmt.Println("error on NewRequest")
-				req.Body.Close()
-				panic(err)
-			}
-
-			res, err = wd.client.Do(req)
-			if err != nil {
-				fmt.Println("error on Client Do Request")
-				res.Body.Close()
-				panic(err)
-			}
-
-			// strategy for strategy
-			// "verified" response will return true
-			// and break out of the loop
-			if v.verify(res) {
-				break
-			}
-
-			if time.Now().After(end) {
-				break
-			}
-
-			time.Sleep(wd.conf.WaitForInterval * time.Millisecond)
-			fmt.Println("retry find element")
-
-			// close res res.Body if not verified
-			// i.e. StrategyRequest returns false
-			res.Body.Close()
-		}
-
-		data, err = io.ReadAll(res.Body)
-		if err != nil {
-			json.NewEncoder(w).Encode(fmt.Errorf("error on get response: %v", err))
-			return
-		}
-
-		defer res.Body.Close()
-
-		w.Header().Set(config.ContenType, config.ApplicationJson)
-		w.Write(data)
-	})
-}
@@ -42,6 +42,11 @@ func Handler() http.Handler {
 	sm.Handle("POST /session", logger(wd.post()))
 	sm.HandleFunc("DELETE /session/{sessionId}", wd.delete())
 	sm.HandleFunc("POST /session/{sessionId}/url", wd.post())
+	sm.HandleFunc("GET /session/{sessionId}/url", wd.get())
+	sm.HandleFunc("POST /session/{sessionId}/back", wd.post())
+	sm.HandleFunc("POST /session/{sessionId}/forward", wd.post())
+	sm.HandleFunc("POST /session/{sessionId}/refresh", wd.post())
+	sm.HandleFunc("GET /session/{sessionId}/title", wd.get())
 
 	sm.Handle("POST /session/{sessionId}/element", logger(wd.retrier(&verifyStatusOk{})))
 	sm.Handle("POST /session/{sessionId}/elements", logger(wd.retrier(&verifyStatusOk{})))
@@ -49,19 +54,55 @@ func Handler() http.Handler {
 	sm.Handle("POST /session/{sessionId}/element/{elementId}/elements", logger(wd.retrier(&verifyStatusOk{})))
 	sm.HandleFunc("GET /session/{sessionId}/element/active", wd.get())
 
+	sm.Handle("GET /session/{sessionId}/element/{elementId}/shadow", logger(wd.retrier(&verifyStatusOk{})))
+	sm.Handle("POST /session/{sessionId}/shadow/{shadowId}/element", logger(wd.retrier(&verifyStatusOk{})))
+	sm.Handle("POST /session/{sessionId}/shadow/{shadowId}/elements", logger(wd.retrier(&verifyStatusOk{})))
+
 	sm.Handle("POST /session/{sessionId}/element/{elementId}/click", wd.retrier(&verifyStatusOk{}))
 	sm.HandleFunc("POST /session/{sessionId}/element/{elementId}/value", wd.post())
+	sm.HandleFunc("POST /session/{sessionId}/element/{elementId}/clear", wd.post())
+	sm.HandleFunc("POST /session/{sessionId}/se/file", wd.post())
 	sm.HandleFunc("GET /session/{sessionId}/element/{elementId}/text", wd.get())
 	sm.Handle("GET /session/{sessionId}/element/{elementId}/displayed", wd.isRetrier(&verifyValue{}))
 	sm.Handle("GET /session/{sessionId}/element/{elementId}/is", wd.isDisplayed(wd.isRetrier(&verifyValue{})))
 	sm.Handle("GET /session/{sessionId}/element/{elementId}/attribute/{attribute}", wd.retrier(&verifyStatusOk{}))
+	sm.HandleFunc("GET /session/{sessionId}/element/{elementId}/property/{property}", wd.get())
+	sm.HandleFunc("GET /session/{sessionId}/element/{elementId}/css/{property}", wd.get())
+	sm.HandleFunc("GET /session/{sessionId}/element/{elementId}/name", wd.get())
+	sm.HandleFunc("GET /session/{sessionId}/element/{elementId}/rect", wd.get())
+	sm.HandleFunc("GET /session/{sessionId}/element/{elementId}/enabled", wd.get())
+	sm.HandleFunc("GET /session/{sessionId}/element/{elementId}/selected", wd.get())
+	sm.HandleFunc("GET /session/{sessionId}/element/{elementId}/computedrole", wd.get())
+	sm.HandleFunc("GET /session/{sessionId}/element/{elementId}/computedlabel", wd.get())
 
 	sm.Handle("POST /session/{sessionId}/script", wd.script(wd.post()))
+	sm.HandleFunc("POST /session/{sessionId}/execute/async", wd.post())
 	sm.HandleFunc("GET /session/{sessionId}/screenshot", wd.get())
 
+	sm.HandleFunc("POST /session/{sessionId}/frame", wd.post())
+	sm.HandleFunc("POST /session/{sessionId}/frame/parent", wd.post())
+
+	sm.HandleFunc("GET /session/{sessionId}/cookie", wd.get())
+	sm.HandleFunc("POST /session/{sessionId}/cookie", wd.post())
+	sm.HandleFunc("DELETE /session/{sessionId}/cookie", wd.delete())
+	sm.HandleFunc("GET /session/{sessionId}/cookie/{name}", wd.get())
+	sm.HandleFunc("DELETE /session/{sessionId}/cookie/{name}", wd.delete())
+
+	sm.HandleFunc("GET /session/{sessionId}/window", wd.get())
 	sm.HandleFunc("POST /session/{sessionId}/window", wd.post())
+	sm.HandleFunc("DELETE /session/{sessionId}/window", wd.delete())
 	sm.HandleFunc("GET /session/{sessionId}/window/handles", wd.get())
 	sm.HandleFunc("POST /session/{sessionId}/window/new", wd.post())
+	sm.HandleFunc("GET /session/{sessionId}/window/rect", wd.get())
+	sm.HandleFunc("POST /session/{sessionId}/window/rect", wd.post())
+	sm.HandleFunc("POST /session/{sessionId}/window/maximize", wd.post())
+	sm.HandleFunc("POST /session/{sessionId}/window/minimize", wd.post())
+	sm.HandleFunc("POST /session/{sessionId}/window/fullscreen", wd.post())
+
+	sm.HandleFunc("GET /session/{sessionId}/alert/text", wd.get())
+	sm.HandleFunc("POST /session/{sessionId}/alert/text", wd.post())
+	sm.HandleFunc("POST /session/{sessionId}/alert/accept", wd.post())
+	sm.HandleFunc("POST /session/{sessionId}/alert/dismiss", wd.post())
 
 	sm.HandleFunc("POST /session/{sessionId}/actions", wd.post())
 	sm.HandleFunc("DELETE /session/{sessionId}/actions", wd.delete())
@@ -70,72 +111,113 @@ func Handler() http.Handler {
 
 func (wd *WebDriverHandler) post() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		url := fmt.Sprintf("%s%s", wd.conf.WebDriverAddr, r.URL.Path)
+		url := fmt.Sprintf("%s%s", wd.conf.WebDriverAddr, r.URL.EscapedPath())
 		data, err := io.ReadAll(r.Body)
 		if err != nil {
-			json.NewEncoder(w).Encode(fmt.Errorf("error on read post request body: %v", err))
+			writeError(w, fmt.Errorf("error on read post request body: %v", err))
 			return
 		}
 
-		res, err := http.Post(url, config.ApplicationJson, bytes.NewBuffer(data))
+		req, err := http.NewRequestWithContext(r.Context(), http.MethodPost, url, bytes.NewBuffer(data))
+		if err != nil {
+			writeError(w, fmt.Errorf("error on post request: %v", err))
+			return
+		}
+
+		req.Header.Set(config.ContenType, config.ApplicationJson)
+
+		res, err := wd.client.Do(req)
 		if err != nil {
-			json.NewEncoder(w).Encode(fmt.Errorf("error on post request: %v", err))
+			writeError(w, fmt.Errorf("error on post request: %v", err))
 			return
 		}
 
 		body, err := io.ReadAll(res.Body)
 		if err != nil {
-			json.NewEncoder(w).Encode(fmt.Errorf("error on read post response: %v", err))
+			writeError(w, fmt.Errorf("error on read post response: %v", err))
 			return
 		}
 		defer res.Body.Close()
 
-		w.Header().Set(config.ContenType, config.ApplicationJson)
-		w.Write(body)
+		writeResponse(w, res.StatusCode, body)
 	}
 }
 
+// get
+// forwards escaped request path
+// i.e. cookie name with '/' stays in single segment
 func (wd *WebDriverHandler) get() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		url := fmt.Sprintf("%s%s", wd.conf.WebDriverAddr, r.URL.Path)
-		res, err := http.Get(url)
+		url := fmt.Sprintf("%s%s", wd.conf.WebDriverAddr, r.URL.EscapedPath())
+		req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, url, nil)
 		if err != nil {
+			writeError(w, fmt.Errorf("error on get request: %v", err))
+			return
+		}
+
+		res, err := wd.client.Do(req)
+		if err != nil {
+			writeError(w, fmt.Errorf("error on get request: %v", err))
 			return
 		}
 
 		data, err := io.ReadAll(res.Body)
 		if err != nil {
-			json.NewEncoder(w).Encode(fmt.Errorf("error on get response: %v", err))
+			writeError(w, fmt.Errorf("error on get response: %v", err))
 			return
 		}
 		defer res.Body.Close()
 
-		w.Header().Set(config.ContenType, config.ApplicationJson)
-		w.Write(data)
+		writeResponse(w, res.StatusCode, data)
 	}
 }
 
 func (wd *WebDriverHandler) delete() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		url := fmt.Sprintf("%s%s", wd.conf.WebDriverAddr, r.URL.Path)
-		wdReq, err := http.NewRequest(http.MethodDelete, url, nil)
+		url := fmt.Sprintf("%s%s", wd.conf.WebDriverAddr, r.URL.EscapedPath())
+		wdReq, err := http.NewRequestWithContext(r.Context(), http.MethodDelete, url, nil)
 		if err != nil {
+			writeError(w, fmt.Errorf("error on delete request: %v", err))
 			return
 		}
 
-		res, err := http.DefaultClient.Do(wdReq)
+		res, err := wd.client.Do(wdReq)
 		if err != nil {
+			writeError(w, fmt.Errorf("error on delete request: %v", err))
 			return
 		}
 
 		data, err := io.ReadAll(res.Body)
 		if err != nil {
-			json.NewEncoder(w).Encode(fmt.Errorf("error on read session response: %v", err))
+			writeError(w, fmt.Errorf("error on read session response: %v", err))
 			return
 		}
 		defer res.Body.Close()
 
-		w.Header().Set(config.ContenType, config.ApplicationJson)
-		w.Write(data)
+		writeResponse(w, res.StatusCode, data)
 	}
 }
+
+// writeResponse
+// forwards webdriver response body
+// with webdriver status code
+func writeResponse(w http.ResponseWriter, status int, body []byte) {
+	w.Header().Set(config.ContenType, config.ApplicationJson)
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+// writeError
+// writes service side error
+// as W3C "unknown error" response
+func writeError(w http.ResponseWriter, err error) {
+	w.Header().Set(config.ContenType, config.ApplicationJson)
+	w.WriteHeader(http.StatusInternalServerError)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"value": map[string]string{
+			"error":      "unknown error",
+			"message":    err.Error(),
+			"stacktrace": "",
+		},
+	})
+}
@@ -0,0 +1,145 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mcsymiv/gost/client"
+	"github.com/mcsymiv/gost/config"
+	"github.com/mcsymiv/gost/driver"
+	"github.com/mcsymiv/gost/fake"
+)
+
+const home = "https://fake.test"
+
+func TestRetrierWaitsForElement(t *testing.T) {
+	d, srv := fake.Gost(t)
+	doc := srv.Page(home)
+
+	d.Open(home)
+
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		srv.Update(func() {
+			doc.Body().Append(fake.El("button", fake.Attr("id", "late")))
+		})
+	}()
+
+	if _, err := d.FE("#late"); err != nil {
+		t.Fatalf("expected retrier to find late element: %v", err)
+	}
+
+	if n := srv.Count("POST /session/{id}/element"); n < 2 {
+		t.Errorf("expected retried find requests, got: %d", n)
+	}
+}
+
+func TestIsRetrierWaitsForDisplayed(t *testing.T) {
+	d, srv := fake.Gost(t)
+	doc := srv.Page(home, fake.El("div", fake.Attr("id", "spinner"), fake.Hidden()))
+
+	d.Open(home)
+	el := d.F("#spinner")
+
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		srv.Update(func() {
+			doc.Find("#spinner").Hidden = false
+		})
+	}()
+
+	if _, err := el.IsE(); err != nil {
+		t.Fatalf("expected element to be displayed: %v", err)
+	}
+}
+
+func TestRetrierForwardsError(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home)
+
+	d.Open(home)
+
+	start := time.Now()
+	if _, err := d.FE("#missing"); err == nil {
+		t.Fatal("expected find error")
+	}
+
+	if time.Since(start) < time.Second {
+		t.Errorf("expected retrier to wait for timeout, took: %v", time.Since(start))
+	}
+}
+
+func TestRetrierWaitTimeoutHeader(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home)
+
+	d.Open(home)
+	config.Config.WaitForTimeout = 5
+
+	ctx := client.WithWaitTimeout(context.Background(), 100*time.Millisecond)
+
+	start := time.Now()
+	_, err := d.WithContext(ctx).FE("#missing")
+	if !errors.Is(err, client.ErrNoSuchElement) {
+		t.Fatalf("expected no such element, got: %v", err)
+	}
+
+	if time.Since(start) > time.Second {
+		t.Errorf("expected request timeout override, took: %v", time.Since(start))
+	}
+}
+
+func TestRetrierStopsOnCancel(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home)
+
+	d.Open(home)
+	config.Config.WaitForTimeout = 5
+
+	wd, cancel := d.WithTimeout(200 * time.Millisecond)
+	defer cancel()
+
+	if _, err := wd.FE("#missing"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got: %v", err)
+	}
+
+	// let service observe client disconnect
+	time.Sleep(100 * time.Millisecond)
+	n := srv.Count("POST /session/{id}/element")
+
+	time.Sleep(300 * time.Millisecond)
+	if m := srv.Count("POST /session/{id}/element"); m != n {
+		t.Errorf("expected retrier to stop on cancel, got %d more find requests", m-n)
+	}
+}
+
+func TestRetrierRefreshOnFindError(t *testing.T) {
+	d, srv := fake.Gost(t)
+	srv.Page(home)
+
+	d.Open(home)
+	config.Config.RefreshOnFindError = true
+
+	if _, err := d.FE("#missing", driver.Timeout(500*time.Millisecond), driver.Interval(50*time.Millisecond)); err == nil {
+		t.Fatal("expected find error")
+	}
+
+	if n := srv.Count("POST /session/{id}/refresh"); n != 1 {
+		t.Errorf("expected single page refresh on failed find, got: %d", n)
+	}
+
+	d.FE("#missing", driver.NoWait())
+	if n := srv.Count("POST /session/{id}/refresh"); n != 1 {
+		t.Errorf("unexpected refresh on single attempt find: %d", n-1)
+	}
+
+	config.Config.RefreshOnFindError = false
+	refreshed := srv.Count("POST /session/{id}/refresh")
+
+	d.FE("#missing", driver.Timeout(200*time.Millisecond))
+	if n := srv.Count("POST /session/{id}/refresh"); n != refreshed {
+		t.Errorf("unexpected refresh with RefreshOnFindError unset: %d", n-refreshed)
+	}
+}
@@ -1,8 +1,10 @@
 package service
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -46,3 +48,31 @@ func (v *verifyValue) verify(res *http.Response) bool {
 
 	return false
 }
+
+// alertOpen
+// unexpected alert open error is not retried
+// alert blocks commands until it is handled
+// response body is restored for forwarding
+func alertOpen(res *http.Response) bool {
+	if res.StatusCode == http.StatusOK {
+		return false
+	}
+
+	b, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return false
+	}
+
+	reply := new(struct {
+		Value struct {
+			Error string `json:"error"`
+		}
+	})
+	if err := json.Unmarshal(b, reply); err != nil {
+		return false
+	}
+
+	return reply.Value.Error == "unexpected alert open"
+}